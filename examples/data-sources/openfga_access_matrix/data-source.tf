@@ -0,0 +1,12 @@
+data "openfga_access_matrix" "example" {
+  store_id = "example_store_id"
+
+  users   = ["user:anne", "user:bob"]
+  objects = ["document:1", "document:2"]
+
+  relations = ["viewer", "editor"]
+}
+
+output "anne_can_view_document_1" {
+  value = data.openfga_access_matrix.example.matrix["user:anne"]["document:1"]["viewer"]
+}
@@ -0,0 +1,24 @@
+data "openfga_batch_check_query" "example" {
+  store_id = "example_store_id"
+
+  checks = {
+    "user-1-can-view" = {
+      user     = "user:user-1"
+      relation = "viewer"
+      object   = "document:document-1"
+    }
+    "user-2-can-edit" = {
+      user     = "user:user-2"
+      relation = "editor"
+      object   = "document:document-1"
+
+      context_json = jsonencode({
+        time = timestamp()
+      })
+    }
+  }
+}
+
+output "user_1_can_view" {
+  value = data.openfga_batch_check_query.example.result["user-1-can-view"].allowed
+}
@@ -0,0 +1,11 @@
+data "openfga_check_explain" "example" {
+  store_id = "example_store_id"
+
+  user     = "user:anne"
+  relation = "viewer"
+  object   = "document:1"
+}
+
+output "access_paths" {
+  value = [for path in data.openfga_check_explain.example.paths : path.chain]
+}
@@ -24,4 +24,17 @@ data "openfga_check_query" "advanced" {
   context_json = jsonencode({
     time = timestamp()
   })
+
+  consistency = "HIGHER_CONSISTENCY"
+}
+
+data "openfga_check_query" "assertion" {
+  store_id = "example_store_id"
+
+  user     = "user:intern"
+  relation = "admin"
+  object   = "org:acme"
+
+  expect   = false
+  severity = "error"
 }
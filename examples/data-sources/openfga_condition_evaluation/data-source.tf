@@ -0,0 +1,43 @@
+data "openfga_authorization_model_document" "example" {
+  dsl = file("path/to/model.fga")
+}
+
+data "openfga_condition_evaluation" "model" {
+  model_json     = data.openfga_authorization_model_document.example.result
+  condition_name = "in_network"
+
+  tuple_context_json = jsonencode({
+    cidr = "192.168.0.0/24"
+  })
+
+  context_json = jsonencode({
+    user_ip = "192.168.0.1"
+  })
+}
+
+data "openfga_condition_evaluation" "condition" {
+  condition = {
+    name       = "grant_window"
+    expression = "current_time < grant_time + grant_duration"
+    parameters = {
+      current_time = {
+        type_name = "TYPE_NAME_TIMESTAMP"
+      }
+      grant_time = {
+        type_name = "TYPE_NAME_TIMESTAMP"
+      }
+      grant_duration = {
+        type_name = "TYPE_NAME_DURATION"
+      }
+    }
+  }
+
+  tuple_context_json = jsonencode({
+    grant_time     = "2024-01-01T00:00:00Z"
+    grant_duration = "1h"
+  })
+
+  context_json = jsonencode({
+    current_time = "2024-01-01T00:30:00Z"
+  })
+}
@@ -0,0 +1,28 @@
+data "openfga_expand_query" "basic" {
+  store_id = "example_store_id"
+
+  relation = "viewer"
+  object   = "document:document-1"
+}
+
+data "openfga_expand_query" "advanced" {
+  store_id = "example_store_id"
+
+  relation = "viewer"
+  object   = "document:document-1"
+
+  contextual_tuples = [
+    {
+      user     = "user:user-1"
+      relation = "viewer"
+      object   = "document:document-1"
+    }
+  ]
+}
+
+output "viewers" {
+  value = [
+    for leaf in data.openfga_expand_query.basic.leaves : leaf.value
+    if leaf.type == "user" && !leaf.subtracted
+  ]
+}
@@ -25,3 +25,14 @@ data "openfga_list_objects_query" "advanced" {
     time = timestamp()
   })
 }
+
+data "openfga_list_objects_query" "limited" {
+  store_id = "example_store_id"
+
+  user     = "user:user-1"
+  relation = "viewer"
+  type     = "document"
+
+  max_results = 1000
+  timeout     = "30s"
+}
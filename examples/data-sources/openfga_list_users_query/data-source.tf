@@ -25,3 +25,14 @@ data "openfga_list_users_query" "advanced" {
     time = timestamp()
   })
 }
+
+data "openfga_list_users_query" "user_filters" {
+  store_id = "example_store_id"
+
+  user_filters = [
+    { type = "user" },
+    { type = "group", relation = "member" },
+  ]
+  relation = "viewer"
+  object   = "document:document-1"
+}
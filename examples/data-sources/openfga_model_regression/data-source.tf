@@ -0,0 +1,18 @@
+data "openfga_model_regression" "example" {
+  store_id                         = "example_store_id"
+  base_authorization_model_id      = "example_base_authorization_model_id"
+  candidate_authorization_model_id = "example_candidate_authorization_model_id"
+
+  queries = [{
+    user     = "user:user-1"
+    relation = "viewer"
+    object   = "document:document-1"
+  }]
+
+  sample = {
+    max_tuples = 500
+    relations  = ["viewer", "editor"]
+  }
+
+  fail_on_difference = true
+}
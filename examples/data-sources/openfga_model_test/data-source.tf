@@ -0,0 +1,47 @@
+data "openfga_authorization_model_document" "example" {
+  dsl = file("path/to/model.fga")
+}
+
+data "openfga_model_test" "store_file" {
+  store_file_path = "${path.module}/store.fga.yaml"
+}
+
+data "openfga_model_test" "inline" {
+  model_json = data.openfga_authorization_model_document.example.result
+
+  tuples = [{
+    user     = "user:user-1"
+    relation = "viewer"
+    object   = "document:document-1"
+  }]
+
+  tests = [{
+    name = "viewers"
+
+    check = [{
+      user   = "user:user-1"
+      object = "document:document-1"
+      assertions = {
+        viewer = true
+      }
+    }]
+
+    list_objects = [{
+      user = "user:user-1"
+      type = "document"
+      assertions = {
+        viewer = ["document:document-1"]
+      }
+    }]
+
+    list_users = [{
+      object = "document:document-1"
+      user_filters = [{
+        type = "user"
+      }]
+      assertions = {
+        viewer = ["user:user-1"]
+      }
+    }]
+  }]
+}
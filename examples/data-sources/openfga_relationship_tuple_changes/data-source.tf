@@ -0,0 +1,16 @@
+data "openfga_relationship_tuple_changes" "example" {
+  store_id = "example_store_id"
+
+  type       = "document"
+  start_time = "2024-01-01T00:00:00Z"
+
+  max_changes = 1000
+}
+
+output "deleted_tuples" {
+  value = [for change in data.openfga_relationship_tuple_changes.example.changes : change if change.operation == "delete"]
+}
+
+output "continuation_token" {
+  value = data.openfga_relationship_tuple_changes.example.next_continuation_token
+}
@@ -11,3 +11,34 @@ data "openfga_relationship_tuples" "query" {
     object   = "document:"
   }
 }
+
+data "openfga_relationship_tuples" "user" {
+  store_id = "example_store_id"
+
+  query = {
+    user = "user:user-1"
+  }
+}
+
+data "openfga_relationship_tuples" "conditional" {
+  store_id = "example_store_id"
+
+  query = {
+    object_type    = "document"
+    condition_name = "non_expired_grant"
+  }
+}
+
+data "openfga_relationship_tuples" "first_page" {
+  store_id = "example_store_id"
+
+  page_size   = 50
+  max_results = 500
+}
+
+data "openfga_relationship_tuples" "next_page" {
+  store_id = "example_store_id"
+
+  max_results        = 500
+  continuation_token = data.openfga_relationship_tuples.first_page.next_continuation_token
+}
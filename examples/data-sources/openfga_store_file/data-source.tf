@@ -0,0 +1,32 @@
+data "openfga_store_file" "example" {
+  store_file_path = "${path.module}/store.fga.yaml"
+}
+
+resource "openfga_store" "example" {
+  name = data.openfga_store_file.example.name
+}
+
+resource "openfga_authorization_model" "example" {
+  store_id   = openfga_store.example.id
+  model_json = data.openfga_store_file.example.model_json
+}
+
+resource "openfga_relationship_tuple" "example" {
+  for_each = {
+    for tuple in data.openfga_store_file.example.tuples :
+    "${tuple.user} ${tuple.relation} ${tuple.object}" => tuple
+  }
+
+  store_id               = openfga_store.example.id
+  authorization_model_id = openfga_authorization_model.example.id
+  user                   = each.value.user
+  relation               = each.value.relation
+  object                 = each.value.object
+  condition              = each.value.condition
+}
+
+data "openfga_model_test" "example" {
+  model_json = data.openfga_store_file.example.model_json
+  tuples     = data.openfga_store_file.example.tuples
+  tests      = data.openfga_store_file.example.tests
+}
@@ -0,0 +1,17 @@
+data "openfga_tuple_file" "seed" {
+  path = "${path.module}/tuples.csv"
+}
+
+resource "openfga_relationship_tuple" "seed" {
+  for_each = {
+    for tuple in data.openfga_tuple_file.seed.relationship_tuples :
+    "${tuple.user} ${tuple.relation} ${tuple.object}" => tuple
+  }
+
+  store_id = "example_store_id"
+
+  user      = each.value.user
+  relation  = each.value.relation
+  object    = each.value.object
+  condition = each.value.condition
+}
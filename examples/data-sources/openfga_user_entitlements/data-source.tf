@@ -0,0 +1,13 @@
+data "openfga_user_entitlements" "example" {
+  store_id = "example_store_id"
+
+  user = "user:anne"
+
+  max_results           = 100
+  timeout               = "30s"
+  max_parallel_requests = 5
+}
+
+output "viewable_documents" {
+  value = data.openfga_user_entitlements.example.entitlements["document"]["viewer"].objects
+}
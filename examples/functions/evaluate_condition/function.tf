@@ -0,0 +1,12 @@
+data "openfga_authorization_model_document" "example" {
+  dsl = file("path/to/model.fga")
+}
+
+output "in_network" {
+  value = provider::openfga::evaluate_condition(
+    data.openfga_authorization_model_document.example.result,
+    "in_network",
+    jsonencode({ cidr = "192.168.0.0/24" }),
+    jsonencode({ user_ip = "192.168.0.1" }),
+  ).result
+}
@@ -18,3 +18,56 @@ provider "openfga" {
   client_secret    = var.openfga_client_secret
   api_token_issuer = var.openfga_api_token_issuer
 }
+
+# Prefer consistency over latency for all queries and reads
+provider "openfga" {
+  api_url = "http://localhost:8080"
+
+  consistency = "HIGHER_CONSISTENCY"
+}
+
+# Cache query results across data sources
+provider "openfga" {
+  api_url = "http://localhost:8080"
+
+  query_cache = {
+    ttl         = "5m"
+    max_entries = 10000
+  }
+}
+
+# Read every relationship tuple individually on refresh
+provider "openfga" {
+  api_url = "http://localhost:8080"
+
+  incremental_refresh = false
+}
+
+# Refresh relationship tuples from a snapshot of all tuples of their store
+provider "openfga" {
+  api_url = "http://localhost:8080"
+
+  snapshot_refresh = true
+}
+
+# Coalesce the writes and deletes of individual relationship tuples
+provider "openfga" {
+  api_url = "http://localhost:8080"
+
+  write_batching = {
+    window   = "20ms"
+    max_size = 100
+  }
+}
+
+# Limit the load on the OpenFGA server
+provider "openfga" {
+  api_url = "http://localhost:8080"
+
+  max_concurrent_requests = 20
+  requests_per_second     = 200
+
+  write_limits = {
+    max_concurrent_requests = 5
+  }
+}
@@ -0,0 +1,2 @@
+# Import with store ID and authorization model ID
+terraform import openfga_authorization_model_rollout.example <store_id>/<authorization_model_id>
@@ -0,0 +1,31 @@
+resource "openfga_store" "example" {
+  name = "example"
+}
+
+data "openfga_authorization_model_document" "example" {
+  dsl = file("path/to/model.fga")
+}
+
+resource "openfga_authorization_model_rollout" "example" {
+  store_id   = openfga_store.example.id
+  model_json = data.openfga_authorization_model_document.example.result
+
+  assertions = [
+    {
+      user        = "user:user-1"
+      relation    = "viewer"
+      object      = "document:document-1"
+      expectation = true
+    },
+    {
+      user        = "user:user-2"
+      relation    = "viewer"
+      object      = "document:document-1"
+      expectation = false
+    },
+  ]
+}
+
+output "authorization_model_id" {
+  value = openfga_authorization_model_rollout.example.active_model_id
+}
@@ -0,0 +1,16 @@
+resource "openfga_store" "example" {
+  name = "example"
+}
+
+resource "openfga_store_file_deployment" "example" {
+  store_id        = openfga_store.example.id
+  store_file_path = "${path.module}/store.fga.yaml"
+}
+
+output "authorization_model_id" {
+  value = openfga_store_file_deployment.example.authorization_model_id
+}
+
+output "tuple_count" {
+  value = openfga_store_file_deployment.example.tuple_count
+}
@@ -56,6 +56,24 @@ func IsStatusNotFound(err error) bool {
 	return false
 }
 
+// IsClientError reports whether the request was rejected with a 4xx status,
+// i.e. it was not processed by the server.
+func IsClientError(err error) bool {
+	var rsc responseStatusCoder
+	if errors.As(err, &rsc) {
+		return rsc.ResponseStatusCode() >= http.StatusBadRequest && rsc.ResponseStatusCode() < http.StatusInternalServerError
+	}
+
+	return false
+}
+
+// IsWriteConflict reports whether a write failed because a tuple to be
+// written already existed or a tuple to be deleted did not exist.
+func IsWriteConflict(err error) bool {
+	var ve fgaValidationErr
+	return errors.As(err, &ve) && ve.ResponseCode() == openfga.ERRORCODE_WRITE_FAILED_DUE_TO_INVALID_INPUT
+}
+
 func IsExpectedOneResultError(err error) bool {
 	return errors.Is(err, ErrNotExactlyOne)
 }
@@ -32,6 +32,51 @@ func TestIsExpectedOneResultError(t *testing.T) {
 	})
 }
 
+func TestIsClientError(t *testing.T) {
+	t.Run("bad request", func(t *testing.T) {
+		if !IsClientError(createBadRequestError()) {
+			t.Fatalf("expected true for bad request")
+		}
+	})
+
+	t.Run("internal server error", func(t *testing.T) {
+		if IsClientError(createResponseStatusCoderError(http.StatusInternalServerError)) {
+			t.Fatalf("expected false for internal server error")
+		}
+	})
+
+	t.Run("error without status", func(t *testing.T) {
+		if IsClientError(errors.New("connection reset")) {
+			t.Fatalf("expected false for error without status")
+		}
+	})
+}
+
+func TestIsWriteConflict(t *testing.T) {
+	t.Run("write failed due to invalid input", func(t *testing.T) {
+		err := validationErr{
+			resp: createHttpErrorResponse(http.StatusBadRequest),
+			code: openfga.ERRORCODE_WRITE_FAILED_DUE_TO_INVALID_INPUT,
+			msg:  "cannot write a tuple which already exists",
+		}
+		if !IsWriteConflict(err) {
+			t.Fatalf("expected true for write conflict")
+		}
+	})
+
+	t.Run("other validation error", func(t *testing.T) {
+		if IsWriteConflict(createValidationOtherCodeError()) {
+			t.Fatalf("expected false for other validation error")
+		}
+	})
+
+	t.Run("nil error", func(t *testing.T) {
+		if IsWriteConflict(nil) {
+			t.Fatalf("expected false for nil error")
+		}
+	})
+}
+
 func TestHandleAPIError(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -0,0 +1,251 @@
+package changefeed
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	openfga "github.com/openfga/go-sdk"
+	"github.com/openfga/go-sdk/client"
+)
+
+const readChangesPageSize = 100
+
+// maxPasses bounds the number of completed passes kept per feed. Resources of
+// the same store usually share a few positions, so older passes are rarely
+// needed again.
+const maxPasses = 100
+
+// headClockSkew is subtracted from the current time when a head is determined
+// by time, so that changes are not missed if the clock of the server is
+// behind. Reading a few changes twice is harmless.
+const headClockSkew = time.Minute
+
+// Feed reads the changes of stores using the ReadChanges API. The changes
+// since a position are read at most once per feed, so that many relationship
+// tuples can be refreshed from a single pass over the changes.
+type Feed struct {
+	client *client.OpenFgaClient
+
+	mutex  sync.Mutex
+	passes map[string]*pass
+	order  []string
+	heads  map[string]*head
+}
+
+// Position is a position in the changes of a store, either a continuation
+// token or, if no token is known, a start time.
+type Position struct {
+	ContinuationToken string    `json:"continuation_token,omitempty"`
+	StartTime         time.Time `json:"start_time,omitzero"`
+}
+
+// IsZero returns whether the position is unknown.
+func (position Position) IsZero() bool {
+	return position.ContinuationToken == "" && position.StartTime.IsZero()
+}
+
+func (position Position) key() string {
+	if position.ContinuationToken != "" {
+		return position.ContinuationToken
+	}
+
+	return position.StartTime.UTC().Format(time.RFC3339Nano)
+}
+
+// pass is a read over the changes of a store since a position, which callers
+// for the same store and position wait for.
+type pass struct {
+	done    chan struct{}
+	changes *Changes
+	err     error
+}
+
+// head is the position from which the changes of a store are read, which
+// callers for the same store wait for while it is determined.
+type head struct {
+	done     chan struct{}
+	position Position
+	err      error
+}
+
+// Changes holds the latest change of every relationship tuple which changed
+// since a position.
+type Changes struct {
+	// Head is the position after the last change.
+	Head Position
+
+	tuples map[string]openfga.TupleChange
+}
+
+func New(client *client.OpenFgaClient) *Feed {
+	return &Feed{
+		client: client,
+		passes: map[string]*pass{},
+		heads:  map[string]*head{},
+	}
+}
+
+// Lookup returns the latest change of the relationship tuple, or false if the
+// tuple did not change.
+func (changes *Changes) Lookup(user string, relation string, object string) (*openfga.TupleChange, bool) {
+	change, ok := changes.tuples[tupleKey(user, relation, object)]
+	if !ok {
+		return nil, false
+	}
+
+	return &change, true
+}
+
+// Changes returns the changes of the store since the position. An invalid or
+// expired position results in an error. Failed passes are not kept, so that
+// the next caller reads the changes again.
+//
+// The pass is shared by all callers for the same store and position and is
+// not cancelled with the context of any of them, while every caller stops
+// waiting once its own context is done.
+func (feed *Feed) Changes(ctx context.Context, storeId string, position Position) (*Changes, error) {
+	key := storeId + "|" + position.key()
+
+	feed.mutex.Lock()
+	pending, ok := feed.passes[key]
+	if !ok {
+		pending = &pass{done: make(chan struct{})}
+		feed.passes[key] = pending
+
+		go feed.read(context.WithoutCancel(ctx), key, storeId, position, pending)
+	}
+	feed.mutex.Unlock()
+
+	select {
+	case <-pending.done:
+		return pending.changes, pending.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (feed *Feed) read(ctx context.Context, key string, storeId string, position Position, pending *pass) {
+	pending.changes, pending.err = feed.readChanges(ctx, storeId, position)
+
+	feed.mutex.Lock()
+	if pending.err != nil {
+		delete(feed.passes, key)
+	} else {
+		done := make(chan struct{})
+		close(done)
+		feed.heads[storeId] = &head{done: done, position: pending.changes.Head}
+		feed.order = append(feed.order, key)
+
+		for len(feed.order) > maxPasses {
+			delete(feed.passes, feed.order[0])
+			feed.order = feed.order[1:]
+		}
+	}
+	feed.mutex.Unlock()
+
+	close(pending.done)
+}
+
+// Head returns a position from which all later changes of the store can be
+// read. It is the head of any previous pass over the changes of the store, or
+// otherwise determined once from the changes since the current time, which
+// avoids reading the whole history of the store. As an earlier position is
+// always safe to read from, the head is shared by all callers for the store,
+// so that their positions and thereby their passes coincide.
+func (feed *Feed) Head(ctx context.Context, storeId string) (Position, error) {
+	feed.mutex.Lock()
+	pending, ok := feed.heads[storeId]
+	if !ok {
+		pending = &head{done: make(chan struct{})}
+		feed.heads[storeId] = pending
+
+		go feed.readHead(context.WithoutCancel(ctx), storeId, pending)
+	}
+	feed.mutex.Unlock()
+
+	select {
+	case <-pending.done:
+		return pending.position, pending.err
+	case <-ctx.Done():
+		return Position{}, ctx.Err()
+	}
+}
+
+func (feed *Feed) readHead(ctx context.Context, storeId string, pending *head) {
+	pending.position, pending.err = feed.readCurrentHead(ctx, storeId)
+
+	feed.mutex.Lock()
+	if pending.err != nil && feed.heads[storeId] == pending {
+		delete(feed.heads, storeId)
+	}
+	feed.mutex.Unlock()
+
+	close(pending.done)
+}
+
+func (feed *Feed) readCurrentHead(ctx context.Context, storeId string) (Position, error) {
+	startTime := time.Now().Add(-headClockSkew)
+
+	options := client.ClientReadChangesOptions{
+		StoreId:  openfga.PtrString(storeId),
+		PageSize: openfga.PtrInt32(readChangesPageSize),
+	}
+
+	response, err := feed.client.ReadChanges(ctx).Options(options).Body(client.ClientReadChangesRequest{StartTime: startTime}).Execute()
+	if err != nil {
+		return Position{}, err
+	}
+
+	// Without changes since the start time, no token is returned
+	if response.GetContinuationToken() != "" {
+		return Position{ContinuationToken: response.GetContinuationToken()}, nil
+	}
+
+	return Position{StartTime: startTime}, nil
+}
+
+func (feed *Feed) readChanges(ctx context.Context, storeId string, position Position) (*Changes, error) {
+	options := client.ClientReadChangesOptions{
+		StoreId:           openfga.PtrString(storeId),
+		PageSize:          openfga.PtrInt32(readChangesPageSize),
+		ContinuationToken: openfga.PtrString(position.ContinuationToken),
+	}
+
+	body := client.ClientReadChangesRequest{}
+	if position.ContinuationToken == "" {
+		body.StartTime = position.StartTime
+	}
+
+	changes := Changes{
+		Head:   position,
+		tuples: map[string]openfga.TupleChange{},
+	}
+
+	for {
+		response, err := feed.client.ReadChanges(ctx).Options(options).Body(body).Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		if response.GetContinuationToken() != "" {
+			changes.Head = Position{ContinuationToken: response.GetContinuationToken()}
+			options.ContinuationToken = openfga.PtrString(response.GetContinuationToken())
+		}
+
+		if len(response.GetChanges()) == 0 {
+			break
+		}
+
+		for _, change := range response.GetChanges() {
+			tuple := change.GetTupleKey()
+			changes.tuples[tupleKey(tuple.GetUser(), tuple.GetRelation(), tuple.GetObject())] = change
+		}
+	}
+
+	return &changes, nil
+}
+
+func tupleKey(user string, relation string, object string) string {
+	return user + " " + relation + " " + object
+}
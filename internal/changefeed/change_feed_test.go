@@ -0,0 +1,261 @@
+package changefeed
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/openfga/go-sdk/client"
+)
+
+const testStoreId = "01ARZ3NDEKTSV4RRFFQ69G5FAV"
+
+const testChanges = `{"changes":[{"tuple_key":{"user":"user:anne","relation":"viewer","object":"document:1"},"operation":"TUPLE_OPERATION_WRITE","timestamp":"2026-01-01T00:00:00Z"}],"continuation_token":"head"}`
+
+func newTestFeed(t *testing.T, handler http.HandlerFunc) *Feed {
+	httpServer := httptest.NewServer(handler)
+	t.Cleanup(httpServer.Close)
+
+	fgaClient, err := client.NewSdkClient(&client.ClientConfiguration{
+		ApiUrl: httpServer.URL,
+	})
+	if err != nil {
+		t.Fatalf("unable to create client: %s", err)
+	}
+
+	return New(fgaClient)
+}
+
+// writeChanges responds with a single change for the first page and without
+// changes for the next page.
+func writeChanges(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if r.URL.Query().Get("continuation_token") == "head" {
+		_, _ = w.Write([]byte(`{"changes":[],"continuation_token":"head"}`))
+		return
+	}
+
+	_, _ = w.Write([]byte(testChanges))
+}
+
+func TestHead(t *testing.T) {
+	t.Run("reads from the current time", func(t *testing.T) {
+		var query atomic.Value
+		feed := newTestFeed(t, func(w http.ResponseWriter, r *http.Request) {
+			query.Store(r.URL.Query())
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"changes":[],"continuation_token":""}`))
+		})
+
+		head, err := feed.Head(context.Background(), testStoreId)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if head.ContinuationToken != "" || time.Since(head.StartTime) > 2*headClockSkew {
+			t.Fatalf("expected a recent start time, got %+v", head)
+		}
+
+		values := query.Load().(url.Values)
+		if values.Get("start_time") == "" || values.Get("continuation_token") != "" {
+			t.Fatalf("expected a read from the start time, got %v", values)
+		}
+	})
+
+	t.Run("prefers a continuation token", func(t *testing.T) {
+		feed := newTestFeed(t, writeChanges)
+
+		head, err := feed.Head(context.Background(), testStoreId)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if head.ContinuationToken != "head" {
+			t.Fatalf("expected the continuation token, got %+v", head)
+		}
+	})
+
+	t.Run("determines the head once per store", func(t *testing.T) {
+		var requests atomic.Int32
+		feed := newTestFeed(t, func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"changes":[],"continuation_token":""}`))
+		})
+
+		heads := make([]Position, 5)
+
+		var wait sync.WaitGroup
+		for index := range heads {
+			wait.Add(1)
+			go func() {
+				defer wait.Done()
+
+				head, err := feed.Head(context.Background(), testStoreId)
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+				heads[index] = head
+			}()
+		}
+		wait.Wait()
+
+		for _, head := range heads {
+			if head != heads[0] {
+				t.Fatalf("expected the same head for all callers, got %+v", heads)
+			}
+		}
+
+		if requests.Load() != 1 {
+			t.Fatalf("expected a single request, got %d", requests.Load())
+		}
+	})
+
+	t.Run("does not keep failed heads", func(t *testing.T) {
+		var failed atomic.Bool
+		feed := newTestFeed(t, func(w http.ResponseWriter, r *http.Request) {
+			if failed.CompareAndSwap(false, true) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"code":"validation_error","message":"invalid request"}`))
+				return
+			}
+
+			writeChanges(w, r)
+		})
+
+		if _, err := feed.Head(context.Background(), testStoreId); err == nil {
+			t.Fatalf("expected an error")
+		}
+
+		if _, err := feed.Head(context.Background(), testStoreId); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	})
+
+	t.Run("continues from the head of a pass", func(t *testing.T) {
+		feed := newTestFeed(t, writeChanges)
+
+		if _, err := feed.Changes(context.Background(), testStoreId, Position{ContinuationToken: "token"}); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		head, err := feed.Head(context.Background(), testStoreId)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if head.ContinuationToken != "head" {
+			t.Fatalf("expected the head of the pass, got %+v", head)
+		}
+	})
+}
+
+func TestChanges(t *testing.T) {
+	t.Run("reads changes once per position", func(t *testing.T) {
+		var requests atomic.Int32
+		feed := newTestFeed(t, func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			writeChanges(w, r)
+		})
+
+		for range 3 {
+			changes, err := feed.Changes(context.Background(), testStoreId, Position{StartTime: time.Now().Truncate(time.Hour)})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if _, ok := changes.Lookup("user:anne", "viewer", "document:1"); !ok {
+				t.Fatalf("expected a change of the relationship tuple")
+			}
+
+			if changes.Head.ContinuationToken != "head" {
+				t.Fatalf("expected the head after the last change, got %+v", changes.Head)
+			}
+		}
+
+		if requests.Load() != 2 {
+			t.Fatalf("expected 2 requests, got %d", requests.Load())
+		}
+	})
+
+	t.Run("does not keep failed passes", func(t *testing.T) {
+		var failed atomic.Bool
+		feed := newTestFeed(t, func(w http.ResponseWriter, r *http.Request) {
+			if failed.CompareAndSwap(false, true) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"code":"invalid_continuation_token","message":"invalid token"}`))
+				return
+			}
+
+			writeChanges(w, r)
+		})
+
+		if _, err := feed.Changes(context.Background(), testStoreId, Position{ContinuationToken: "token"}); err == nil {
+			t.Fatalf("expected an error")
+		}
+
+		if _, err := feed.Changes(context.Background(), testStoreId, Position{ContinuationToken: "token"}); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	})
+
+	t.Run("does not fail other callers once a caller is cancelled", func(t *testing.T) {
+		release := make(chan struct{})
+		feed := newTestFeed(t, func(w http.ResponseWriter, r *http.Request) {
+			<-release
+			writeChanges(w, r)
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		cancelled := make(chan error)
+		go func() {
+			_, err := feed.Changes(ctx, testStoreId, Position{ContinuationToken: "token"})
+			cancelled <- err
+		}()
+
+		waiting := make(chan error)
+		go func() {
+			// Wait for the first caller to start the pass
+			time.Sleep(20 * time.Millisecond)
+			_, err := feed.Changes(context.Background(), testStoreId, Position{ContinuationToken: "token"})
+			waiting <- err
+		}()
+
+		time.Sleep(50 * time.Millisecond)
+		cancel()
+
+		if err := <-cancelled; !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected the cancelled caller to stop waiting, got %v", err)
+		}
+
+		close(release)
+
+		if err := <-waiting; err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	})
+
+	t.Run("evicts old passes", func(t *testing.T) {
+		feed := newTestFeed(t, writeChanges)
+
+		for index := range maxPasses + 10 {
+			position := Position{StartTime: time.Unix(int64(index), 0)}
+			if _, err := feed.Changes(context.Background(), testStoreId, position); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		}
+
+		if len(feed.passes) != maxPasses {
+			t.Fatalf("expected %d passes, got %d", maxPasses, len(feed.passes))
+		}
+	})
+}
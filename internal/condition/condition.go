@@ -0,0 +1,146 @@
+package condition
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"reflect"
+	"sort"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common"
+	celtypes "github.com/google/cel-go/common/types"
+
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+)
+
+type EvaluationResult struct {
+	ConditionMet      bool
+	MissingParameters []string
+}
+
+// EvaluableCondition evaluates a condition of an authorization model locally,
+// following the same semantics as the OpenFGA server.
+type EvaluableCondition struct {
+	condition      *openfgav1.Condition
+	parameterTypes map[string]*ParameterType
+	environment    *cel.Env
+	program        cel.Program
+}
+
+func NewEvaluableCondition(condition *openfgav1.Condition) (*EvaluableCondition, error) {
+	parameterTypes := map[string]*ParameterType{}
+	environmentOptions := ipAddressEnvOptions()
+
+	for parameterName, parameterTypeRef := range condition.GetParameters() {
+		parameterType, err := DecodeParameterType(parameterTypeRef)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile expression on condition '%s' - failed to decode parameter type for parameter '%s': %s", condition.GetName(), parameterName, err)
+		}
+
+		parameterTypes[parameterName] = parameterType
+		environmentOptions = append(environmentOptions, cel.Variable(parameterName, parameterType.CelType()))
+	}
+
+	environment, err := cel.NewEnv(environmentOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile expression on condition '%s' - %s", condition.GetName(), err)
+	}
+
+	ast, issues := environment.CompileSource(common.NewStringSource(condition.GetExpression(), condition.GetName()))
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("failed to compile expression on condition '%s' - %s", condition.GetName(), issues.Err())
+	}
+
+	if !reflect.DeepEqual(ast.OutputType(), cel.BoolType) {
+		return nil, fmt.Errorf("failed to compile expression on condition '%s' - expected a bool condition expression output, but got '%s'", condition.GetName(), ast.OutputType())
+	}
+
+	program, err := environment.Program(ast, cel.EvalOptions(cel.OptPartialEval))
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile expression on condition '%s' - condition expression construction: %s", condition.GetName(), err)
+	}
+
+	return &EvaluableCondition{
+		condition:      condition,
+		parameterTypes: parameterTypes,
+		environment:    environment,
+		program:        program,
+	}, nil
+}
+
+// Evaluate merges the request context with the tuple context, where values of
+// the tuple context take precedence, and evaluates the condition expression.
+// If parameters are missing, the condition is never considered to be met.
+func (e *EvaluableCondition) Evaluate(ctx context.Context, tupleContext map[string]interface{}, requestContext map[string]interface{}) (*EvaluationResult, error) {
+	mergedContext := map[string]interface{}{}
+	maps.Copy(mergedContext, requestContext)
+	maps.Copy(mergedContext, tupleContext)
+
+	typedParameters, err := e.castContextToTypedParameters(mergedContext)
+	if err != nil {
+		return nil, err
+	}
+
+	activation, err := e.environment.PartialVars(typedParameters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate relationship condition: '%s' - failed to construct condition partial vars: %s", e.condition.GetName(), err)
+	}
+
+	missingParameters := []string{}
+	for parameterName := range e.parameterTypes {
+		if _, ok := activation.ResolveName(parameterName); !ok {
+			missingParameters = append(missingParameters, parameterName)
+		}
+	}
+	sort.Strings(missingParameters)
+
+	output, _, err := e.program.ContextEval(ctx, activation)
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate relationship condition: '%s' - failed to evaluate condition expression: %s", e.condition.GetName(), err)
+	}
+
+	if celtypes.IsUnknown(output) {
+		return &EvaluationResult{
+			ConditionMet:      false,
+			MissingParameters: missingParameters,
+		}, nil
+	}
+
+	conditionMet, ok := output.Value().(bool)
+	if !ok {
+		return nil, fmt.Errorf("failed to evaluate relationship condition: '%s' - failed to convert condition output to bool", e.condition.GetName())
+	}
+
+	return &EvaluationResult{
+		ConditionMet:      conditionMet,
+		MissingParameters: missingParameters,
+	}, nil
+}
+
+func (e *EvaluableCondition) castContextToTypedParameters(context map[string]interface{}) (map[string]interface{}, error) {
+	if len(context) == 0 {
+		return map[string]interface{}{}, nil
+	}
+
+	if len(e.parameterTypes) == 0 {
+		return nil, fmt.Errorf("parameter type error on condition '%s' - no parameters defined for the condition", e.condition.GetName())
+	}
+
+	typedParameters := map[string]interface{}{}
+	for parameterName, parameterType := range e.parameterTypes {
+		value, ok := context[parameterName]
+		if !ok {
+			continue
+		}
+
+		typedValue, err := parameterType.ConvertValue(value)
+		if err != nil {
+			return nil, fmt.Errorf("parameter type error on condition '%s' - failed to convert context parameter '%s': %s", e.condition.GetName(), parameterName, err)
+		}
+
+		typedParameters[parameterName] = typedValue
+	}
+
+	return typedParameters, nil
+}
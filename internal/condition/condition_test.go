@@ -0,0 +1,163 @@
+package condition
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+)
+
+func newCondition(name string, expression string, parameters map[string]openfgav1.ConditionParamTypeRef_TypeName) *openfgav1.Condition {
+	parameterTypeRefs := map[string]*openfgav1.ConditionParamTypeRef{}
+	for parameterName, typeName := range parameters {
+		parameterTypeRefs[parameterName] = &openfgav1.ConditionParamTypeRef{TypeName: typeName}
+	}
+
+	return &openfgav1.Condition{
+		Name:       name,
+		Expression: expression,
+		Parameters: parameterTypeRefs,
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	testCases := []struct {
+		name                      string
+		condition                 *openfgav1.Condition
+		tupleContext              map[string]interface{}
+		requestContext            map[string]interface{}
+		expectedConditionMet      bool
+		expectedMissingParameters []string
+		expectedError             bool
+	}{
+		{
+			name: "condition met with merged contexts",
+			condition: newCondition("larger_than", "provided > required", map[string]openfgav1.ConditionParamTypeRef_TypeName{
+				"provided": openfgav1.ConditionParamTypeRef_TYPE_NAME_INT,
+				"required": openfgav1.ConditionParamTypeRef_TYPE_NAME_INT,
+			}),
+			tupleContext:              map[string]interface{}{"required": float64(50)},
+			requestContext:            map[string]interface{}{"provided": float64(100)},
+			expectedConditionMet:      true,
+			expectedMissingParameters: []string{},
+		},
+		{
+			name: "tuple context takes precedence over request context",
+			condition: newCondition("larger_than", "provided > required", map[string]openfgav1.ConditionParamTypeRef_TypeName{
+				"provided": openfgav1.ConditionParamTypeRef_TYPE_NAME_INT,
+				"required": openfgav1.ConditionParamTypeRef_TYPE_NAME_INT,
+			}),
+			tupleContext:              map[string]interface{}{"required": float64(200)},
+			requestContext:            map[string]interface{}{"provided": float64(100), "required": float64(50)},
+			expectedConditionMet:      false,
+			expectedMissingParameters: []string{},
+		},
+		{
+			name: "missing parameters are reported",
+			condition: newCondition("larger_than", "provided > required", map[string]openfgav1.ConditionParamTypeRef_TypeName{
+				"provided": openfgav1.ConditionParamTypeRef_TYPE_NAME_INT,
+				"required": openfgav1.ConditionParamTypeRef_TYPE_NAME_INT,
+			}),
+			tupleContext:              map[string]interface{}{"required": float64(50)},
+			expectedConditionMet:      false,
+			expectedMissingParameters: []string{"provided"},
+		},
+		{
+			name: "ip address within cidr",
+			condition: newCondition("in_network", "user_ip.in_cidr(cidr)", map[string]openfgav1.ConditionParamTypeRef_TypeName{
+				"user_ip": openfgav1.ConditionParamTypeRef_TYPE_NAME_IPADDRESS,
+				"cidr":    openfgav1.ConditionParamTypeRef_TYPE_NAME_STRING,
+			}),
+			tupleContext:              map[string]interface{}{"cidr": "192.168.0.0/24"},
+			requestContext:            map[string]interface{}{"user_ip": "192.168.0.1"},
+			expectedConditionMet:      true,
+			expectedMissingParameters: []string{},
+		},
+		{
+			name: "timestamp within grant window",
+			condition: newCondition("grant_window", "current_time < grant_time + grant_duration", map[string]openfgav1.ConditionParamTypeRef_TypeName{
+				"current_time":   openfgav1.ConditionParamTypeRef_TYPE_NAME_TIMESTAMP,
+				"grant_time":     openfgav1.ConditionParamTypeRef_TYPE_NAME_TIMESTAMP,
+				"grant_duration": openfgav1.ConditionParamTypeRef_TYPE_NAME_DURATION,
+			}),
+			tupleContext:              map[string]interface{}{"grant_time": "2024-01-01T00:00:00Z", "grant_duration": "1h"},
+			requestContext:            map[string]interface{}{"current_time": "2024-01-01T00:30:00Z"},
+			expectedConditionMet:      true,
+			expectedMissingParameters: []string{},
+		},
+		{
+			name: "uint beyond the int64 range",
+			condition: newCondition("larger_than_int64", "provided > 9223372036854775807u", map[string]openfgav1.ConditionParamTypeRef_TypeName{
+				"provided": openfgav1.ConditionParamTypeRef_TYPE_NAME_UINT,
+			}),
+			requestContext:            map[string]interface{}{"provided": "18446744073709551615"},
+			expectedConditionMet:      true,
+			expectedMissingParameters: []string{},
+		},
+		{
+			name: "negative uint",
+			condition: newCondition("larger_than_int64", "provided > 9223372036854775807u", map[string]openfgav1.ConditionParamTypeRef_TypeName{
+				"provided": openfgav1.ConditionParamTypeRef_TYPE_NAME_UINT,
+			}),
+			requestContext: map[string]interface{}{"provided": float64(-1)},
+			expectedError:  true,
+		},
+		{
+			name: "invalid parameter value",
+			condition: newCondition("larger_than", "provided > required", map[string]openfgav1.ConditionParamTypeRef_TypeName{
+				"provided": openfgav1.ConditionParamTypeRef_TYPE_NAME_INT,
+				"required": openfgav1.ConditionParamTypeRef_TYPE_NAME_INT,
+			}),
+			requestContext: map[string]interface{}{"provided": 1.5},
+			expectedError:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			evaluableCondition, err := NewEvaluableCondition(tc.condition)
+			if err != nil {
+				t.Fatalf("unexpected compilation error: %s", err)
+			}
+
+			result, err := evaluableCondition.Evaluate(context.Background(), tc.tupleContext, tc.requestContext)
+			if tc.expectedError {
+				if err == nil {
+					t.Fatalf("expected an error, but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected evaluation error: %s", err)
+			}
+
+			if result.ConditionMet != tc.expectedConditionMet {
+				t.Errorf("expected condition met to be %v, but got %v", tc.expectedConditionMet, result.ConditionMet)
+			}
+
+			if !reflect.DeepEqual(result.MissingParameters, tc.expectedMissingParameters) {
+				t.Errorf("expected missing parameters %v, but got %v", tc.expectedMissingParameters, result.MissingParameters)
+			}
+		})
+	}
+}
+
+func TestNewEvaluableCondition(t *testing.T) {
+	t.Run("non boolean expression", func(t *testing.T) {
+		_, err := NewEvaluableCondition(newCondition("not_bool", "value + 1", map[string]openfgav1.ConditionParamTypeRef_TypeName{
+			"value": openfgav1.ConditionParamTypeRef_TYPE_NAME_INT,
+		}))
+		if err == nil {
+			t.Fatalf("expected an error for non boolean expression")
+		}
+	})
+
+	t.Run("undeclared parameter", func(t *testing.T) {
+		_, err := NewEvaluableCondition(newCondition("undeclared", "value > 1", nil))
+		if err == nil {
+			t.Fatalf("expected an error for undeclared parameter")
+		}
+	})
+}
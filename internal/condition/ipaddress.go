@@ -0,0 +1,120 @@
+package condition
+
+import (
+	"fmt"
+	"net/netip"
+	"reflect"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/common/types/traits"
+)
+
+var ipAddressCelType = cel.ObjectType("IPAddress", traits.ReceiverType)
+
+// IPAddress is the CEL value backing the `ipaddress` condition parameter type.
+type IPAddress struct {
+	addr netip.Addr
+}
+
+func ParseIPAddress(ip string) (IPAddress, error) {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return IPAddress{}, err
+	}
+
+	return IPAddress{addr: addr}, nil
+}
+
+func (ip IPAddress) ConvertToNative(typeDesc reflect.Type) (interface{}, error) {
+	if reflect.TypeOf(ip).AssignableTo(typeDesc) {
+		return ip, nil
+	}
+
+	if typeDesc == reflect.TypeOf("") {
+		return ip.addr.String(), nil
+	}
+
+	return nil, fmt.Errorf("failed to convert from type '%s' to native Go type 'IPAddress'", typeDesc)
+}
+
+func (ip IPAddress) ConvertToType(typeValue ref.Type) ref.Val {
+	switch typeValue {
+	case types.StringType:
+		return types.String(ip.addr.String())
+	case types.TypeType:
+		return ipAddressCelType
+	default:
+		return types.NewErr("failed to convert from CEL type '%s' to '%s'", ipAddressCelType, typeValue)
+	}
+}
+
+func (ip IPAddress) Equal(other ref.Val) ref.Val {
+	otherIp, ok := other.(IPAddress)
+	if !ok {
+		return types.NoSuchOverloadErr()
+	}
+
+	return types.Bool(ip.addr.Compare(otherIp.addr) == 0)
+}
+
+func (ip IPAddress) Type() ref.Type {
+	return ipAddressCelType
+}
+
+func (ip IPAddress) Value() interface{} {
+	return ip
+}
+
+func ipAddressEnvOptions() []cel.EnvOption {
+	return []cel.EnvOption{
+		cel.Function("ipaddress",
+			cel.Overload("string_to_ipaddress",
+				[]*cel.Type{cel.StringType},
+				ipAddressCelType,
+				cel.UnaryBinding(stringToIPAddress),
+			),
+		),
+		cel.Function("in_cidr",
+			cel.MemberOverload("ipaddr_in_cidr",
+				[]*cel.Type{ipAddressCelType, cel.StringType},
+				cel.BoolType,
+				cel.BinaryBinding(ipAddressInCidr),
+			),
+		),
+	}
+}
+
+func stringToIPAddress(arg ref.Val) ref.Val {
+	ip, ok := arg.Value().(string)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(arg)
+	}
+
+	ipAddress, err := ParseIPAddress(ip)
+	if err != nil {
+		return types.NewErr("%s", err.Error())
+	}
+
+	return ipAddress
+}
+
+func ipAddressInCidr(lhs ref.Val, rhs ref.Val) ref.Val {
+	cidr, ok := rhs.Value().(string)
+	if !ok {
+		return types.NewErr("a CIDR string is required for comparison")
+	}
+
+	network, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return types.NewErr("'%s' is a malformed CIDR string", cidr)
+	}
+
+	ipAddress, ok := lhs.(IPAddress)
+	if !ok {
+		return types.NewErr("an IPAddress parameter value is required for comparison")
+	}
+
+	return types.Bool(network.Contains(ipAddress.addr))
+}
@@ -0,0 +1,233 @@
+package condition
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"time"
+
+	"github.com/google/cel-go/cel"
+
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+)
+
+// ParameterType mirrors the parameter types OpenFGA supports in conditions
+// together with the conversion applied to context values before evaluation.
+type ParameterType struct {
+	celType   *cel.Type
+	converter func(value interface{}) (interface{}, error)
+}
+
+func (parameterType ParameterType) CelType() *cel.Type {
+	return parameterType.celType
+}
+
+func (parameterType ParameterType) ConvertValue(value interface{}) (interface{}, error) {
+	return parameterType.converter(value)
+}
+
+var primitiveParameterTypes = map[openfgav1.ConditionParamTypeRef_TypeName]ParameterType{
+	openfgav1.ConditionParamTypeRef_TYPE_NAME_ANY:       {celType: cel.AnyType, converter: anyConverter},
+	openfgav1.ConditionParamTypeRef_TYPE_NAME_BOOL:      {celType: cel.BoolType, converter: primitiveConverter[bool]},
+	openfgav1.ConditionParamTypeRef_TYPE_NAME_STRING:    {celType: cel.StringType, converter: primitiveConverter[string]},
+	openfgav1.ConditionParamTypeRef_TYPE_NAME_INT:       {celType: cel.IntType, converter: numericConverter[int64]},
+	openfgav1.ConditionParamTypeRef_TYPE_NAME_UINT:      {celType: cel.UintType, converter: numericConverter[uint64]},
+	openfgav1.ConditionParamTypeRef_TYPE_NAME_DOUBLE:    {celType: cel.DoubleType, converter: numericConverter[float64]},
+	openfgav1.ConditionParamTypeRef_TYPE_NAME_DURATION:  {celType: cel.DurationType, converter: durationConverter},
+	openfgav1.ConditionParamTypeRef_TYPE_NAME_TIMESTAMP: {celType: cel.TimestampType, converter: timestampConverter},
+	openfgav1.ConditionParamTypeRef_TYPE_NAME_IPADDRESS: {celType: ipAddressCelType, converter: ipAddressConverter},
+}
+
+func DecodeParameterType(typeRef *openfgav1.ConditionParamTypeRef) (*ParameterType, error) {
+	typeName := typeRef.GetTypeName()
+	genericTypeRefs := typeRef.GetGenericTypes()
+
+	switch typeName {
+	case openfgav1.ConditionParamTypeRef_TYPE_NAME_MAP, openfgav1.ConditionParamTypeRef_TYPE_NAME_LIST:
+		if len(genericTypeRefs) != 1 {
+			return nil, fmt.Errorf("condition parameter type `%s` requires 1 generic types; found %d", typeName, len(genericTypeRefs))
+		}
+
+		genericType, err := DecodeParameterType(genericTypeRefs[0])
+		if err != nil {
+			return nil, err
+		}
+
+		if typeName == openfgav1.ConditionParamTypeRef_TYPE_NAME_MAP {
+			return newMapParameterType(*genericType), nil
+		}
+
+		return newListParameterType(*genericType), nil
+	}
+
+	parameterType, ok := primitiveParameterTypes[typeName]
+	if !ok {
+		return nil, fmt.Errorf("unknown condition parameter type `%s`", typeName)
+	}
+
+	if len(genericTypeRefs) != 0 {
+		return nil, fmt.Errorf("condition parameter type `%s` requires 0 generic types; found %d", typeName, len(genericTypeRefs))
+	}
+
+	return &parameterType, nil
+}
+
+func newMapParameterType(genericType ParameterType) *ParameterType {
+	return &ParameterType{
+		celType: cel.MapType(cel.StringType, genericType.celType),
+		converter: func(value interface{}) (interface{}, error) {
+			values, ok := value.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("map requires a map, found: %T", value)
+			}
+
+			converted := make(map[string]interface{}, len(values))
+			for key, item := range values {
+				convertedItem, err := genericType.ConvertValue(item)
+				if err != nil {
+					return nil, fmt.Errorf("found an invalid value for key '%s': %w", key, err)
+				}
+
+				converted[key] = convertedItem
+			}
+
+			return converted, nil
+		},
+	}
+}
+
+func newListParameterType(genericType ParameterType) *ParameterType {
+	return &ParameterType{
+		celType: cel.ListType(genericType.celType),
+		converter: func(value interface{}) (interface{}, error) {
+			values, ok := value.([]interface{})
+			if !ok {
+				return nil, fmt.Errorf("list requires a list, found: %T", value)
+			}
+
+			converted := make([]interface{}, len(values))
+			for index, item := range values {
+				convertedItem, err := genericType.ConvertValue(item)
+				if err != nil {
+					return nil, fmt.Errorf("found an invalid list item at index `%d`: %w", index, err)
+				}
+
+				converted[index] = convertedItem
+			}
+
+			return converted, nil
+		},
+	}
+}
+
+func anyConverter(value interface{}) (interface{}, error) {
+	return value, nil
+}
+
+func primitiveConverter[T any](value interface{}) (interface{}, error) {
+	converted, ok := value.(T)
+	if !ok {
+		return nil, fmt.Errorf("expected type value '%T', but found '%s'", *new(T), reflect.TypeOf(value))
+	}
+
+	return converted, nil
+}
+
+func numericConverter[T int64 | uint64 | float64](value interface{}) (interface{}, error) {
+	if converted, ok := value.(T); ok {
+		return converted, nil
+	}
+
+	var bigFloat *big.Float
+	switch typedValue := value.(type) {
+	case float64:
+		bigFloat = big.NewFloat(typedValue)
+	case string:
+		parsed, _, err := big.ParseFloat(typedValue, 10, 64, 0)
+		if err != nil {
+			return nil, fmt.Errorf("expected a %T value, but found invalid string value '%v'", *new(T), value)
+		}
+
+		bigFloat = parsed
+	default:
+		return nil, fmt.Errorf("expected type value '%T', but found '%s'", *new(T), reflect.TypeOf(value))
+	}
+
+	switch any(*new(T)).(type) {
+	case int64:
+		if !bigFloat.IsInt() {
+			return nil, fmt.Errorf("expected an int value, but found numeric value '%s'", bigFloat.String())
+		}
+
+		converted, _ := bigFloat.Int64()
+		return converted, nil
+	case uint64:
+		if !bigFloat.IsInt() {
+			return nil, fmt.Errorf("expected a uint value, but found numeric value '%s'", bigFloat.String())
+		}
+
+		if bigFloat.Sign() < 0 {
+			return nil, fmt.Errorf("expected a uint value, but found int64 value '%s'", bigFloat.String())
+		}
+
+		converted, accuracy := bigFloat.Uint64()
+		if accuracy != big.Exact {
+			return nil, fmt.Errorf("number cannot be represented as a uint64: %s", bigFloat.String())
+		}
+
+		return converted, nil
+	default:
+		converted, accuracy := bigFloat.Float64()
+		if accuracy == big.Above || accuracy == big.Below {
+			return nil, fmt.Errorf("number cannot be represented as a float64: %s", bigFloat.String())
+		}
+
+		return converted, nil
+	}
+}
+
+func durationConverter(value interface{}) (interface{}, error) {
+	stringValue, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("expected a duration string, but found: %T '%v'", value, value)
+	}
+
+	duration, err := time.ParseDuration(stringValue)
+	if err != nil {
+		return nil, fmt.Errorf("expected a valid duration string, but found: '%v'", value)
+	}
+
+	return duration, nil
+}
+
+func timestampConverter(value interface{}) (interface{}, error) {
+	stringValue, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("expected RFC 3339 formatted timestamp string, but found: %T '%v'", value, value)
+	}
+
+	timestamp, err := time.Parse(time.RFC3339, stringValue)
+	if err != nil {
+		return nil, fmt.Errorf("expected RFC 3339 formatted timestamp string, but found '%s'", stringValue)
+	}
+
+	return timestamp, nil
+}
+
+func ipAddressConverter(value interface{}) (interface{}, error) {
+	if ipAddress, ok := value.(IPAddress); ok {
+		return ipAddress, nil
+	}
+
+	stringValue, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("expected an ipaddress string, but found: %T '%v'", value, value)
+	}
+
+	ipAddress, err := ParseIPAddress(stringValue)
+	if err != nil {
+		return nil, fmt.Errorf("expected a well-formed IP address, but found: '%s'", stringValue)
+	}
+
+	return ipAddress, nil
+}
@@ -0,0 +1,171 @@
+package access
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	openfga "github.com/openfga/go-sdk"
+	"github.com/openfga/go-sdk/client"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/openfga/terraform-provider-openfga/internal/provider/authorizationmodel"
+	"github.com/openfga/terraform-provider-openfga/internal/provider/query"
+	"github.com/openfga/terraform-provider-openfga/internal/querycache"
+)
+
+type AccessClient struct {
+	authorizationModelClient *authorizationmodel.AuthorizationModelClient
+	queryClient              *query.QueryClient
+}
+
+func NewAccessClient(client *client.OpenFgaClient, consistency openfga.ConsistencyPreference, cache *querycache.Cache) *AccessClient {
+	return &AccessClient{
+		authorizationModelClient: authorizationmodel.NewAuthorizationModelClient(client),
+		queryClient:              query.NewQueryClient(client, consistency, cache),
+	}
+}
+
+// ReadRelations returns the ID of the given or latest authorization model of
+// the store, together with the relations of all its types.
+func (wrapper *AccessClient) ReadRelations(ctx context.Context, storeId string, authorizationModelId string) (string, map[string][]string, error) {
+	var authorizationModel *authorizationmodel.AuthorizationModelModel
+	var err error
+
+	if authorizationModelId == "" {
+		authorizationModel, err = wrapper.authorizationModelClient.ReadLatestAuthorizationModel(ctx, storeId)
+	} else {
+		authorizationModel, err = wrapper.authorizationModelClient.ReadAuthorizationModel(ctx, storeId, *authorizationmodel.NewAuthorizationModelModel(authorizationModelId))
+	}
+	if err != nil {
+		return "", nil, err
+	}
+
+	relations, err := authorizationModel.GetRelations()
+	if err != nil {
+		return "", nil, err
+	}
+
+	return authorizationModel.GetId(), relations, nil
+}
+
+// AccessMatrix checks every relation for every combination of users and
+// objects. If no relations are given, all relations of the type of an object
+// are checked. Relations which are not defined on the type of an object are
+// omitted from the matrix. The ID of the authorization model the checks were
+// performed against is returned as well.
+func (wrapper *AccessClient) AccessMatrix(ctx context.Context, storeId string, authorizationModelId string, model AccessMatrixQueryModel, consistency string) (string, *AccessMatrixResultModel, error) {
+	authorizationModelId, typeRelations, err := wrapper.ReadRelations(ctx, storeId, authorizationModelId)
+	if err != nil {
+		return "", nil, err
+	}
+
+	cells := []AccessMatrixCell{}
+	for _, user := range model.GetUsers() {
+		for _, object := range model.GetObjects() {
+			objectType, _, _ := strings.Cut(object, ":")
+
+			relations, ok := typeRelations[objectType]
+			if !ok {
+				return "", nil, fmt.Errorf("type %q of object %q is not defined in authorization model %q", objectType, object, authorizationModelId)
+			}
+
+			if model.Relations != nil {
+				relations = intersect(model.GetRelations(), relations)
+			}
+
+			for _, relation := range relations {
+				cells = append(cells, AccessMatrixCell{User: user, Object: object, Relation: relation})
+			}
+		}
+	}
+
+	checks := map[string]query.CheckQueryModel{}
+	for index, cell := range cells {
+		checks[strconv.Itoa(index)] = *query.NewCheckQueryModel(cell.User, cell.Relation, cell.Object, nil, nil)
+	}
+
+	results, err := wrapper.queryClient.BatchCheck(ctx, storeId, authorizationModelId, checks, model.GetMaxBatchSize(), model.GetMaxParallelRequests(), consistency)
+	if err != nil {
+		return "", nil, err
+	}
+
+	for index := range cells {
+		result := results[strconv.Itoa(index)]
+		if !result.Error.IsNull() {
+			return "", nil, fmt.Errorf("check (user=%s, relation=%s, object=%s) failed: %s", cells[index].User, cells[index].Relation, cells[index].Object, result.Error.ValueString())
+		}
+
+		cells[index].Allowed = result.Allowed.ValueBool()
+	}
+
+	return authorizationModelId, NewAccessMatrixResultModel(model.GetUsers(), model.GetObjects(), cells), nil
+}
+
+func intersect(values []string, allowed []string) []string {
+	allowedSet := map[string]bool{}
+	for _, value := range allowed {
+		allowedSet[value] = true
+	}
+
+	result := []string{}
+	for _, value := range values {
+		if allowedSet[value] {
+			result = append(result, value)
+		}
+	}
+
+	return result
+}
+
+// UserEntitlements lists the objects the user has each relation to, for every
+// relation of every type of the authorization model. At most
+// maxParallelRequests queries are performed concurrently, and maxResults and
+// timeout apply to each query individually. The ID of the authorization model
+// the queries were performed against is returned as well.
+func (wrapper *AccessClient) UserEntitlements(ctx context.Context, storeId string, authorizationModelId string, user string, consistency string, maxResults int, timeout time.Duration, maxParallelRequests int) (string, *UserEntitlementsResultModel, error) {
+	authorizationModelId, typeRelations, err := wrapper.ReadRelations(ctx, storeId, authorizationModelId)
+	if err != nil {
+		return "", nil, err
+	}
+
+	entitlements := map[string]map[string]UserEntitlementModel{}
+	for objectType := range typeRelations {
+		entitlements[objectType] = map[string]UserEntitlementModel{}
+	}
+
+	// The first failed query cancels the remaining ones
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.SetLimit(maxParallelRequests)
+
+	var mutex sync.Mutex
+
+	for objectType, relations := range typeRelations {
+		for _, relation := range relations {
+			group.Go(func() error {
+				result, err := wrapper.queryClient.ListObjects(groupCtx, storeId, authorizationModelId, *query.NewListObjectsQueryModel(user, relation, objectType, nil, nil), consistency, maxResults, timeout)
+				if err != nil {
+					return fmt.Errorf("list objects (user=%s, relation=%s, type=%s) failed: %w", user, relation, objectType, err)
+				}
+
+				mutex.Lock()
+				defer mutex.Unlock()
+
+				entitlements[objectType][relation] = *NewUserEntitlementModel(*result)
+
+				return nil
+			})
+		}
+	}
+
+	if err := group.Wait(); err != nil {
+		return "", nil, err
+	}
+
+	return authorizationModelId, &UserEntitlementsResultModel{
+		Entitlements: entitlements,
+	}, nil
+}
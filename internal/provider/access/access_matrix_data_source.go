@@ -0,0 +1,150 @@
+package access
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/openfga/terraform-provider-openfga/internal/provider/providerdata"
+	"github.com/openfga/terraform-provider-openfga/internal/provider/relationshiptuple"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AccessMatrixDataSource{}
+var _ datasource.DataSourceWithConfigure = &AccessMatrixDataSource{}
+
+func NewAccessMatrixDataSource() datasource.DataSource {
+	return &AccessMatrixDataSource{}
+}
+
+type AccessMatrixDataSource struct {
+	client *AccessClient
+}
+
+type AccessMatrixDataSourceModel struct {
+	StoreId              types.String `tfsdk:"store_id"`
+	AuthorizationModelId types.String `tfsdk:"authorization_model_id"`
+
+	AccessMatrixQueryModel
+	relationshiptuple.ConsistencyModel
+
+	AccessMatrixResultModel
+}
+
+func (d *AccessMatrixDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_matrix"
+}
+
+func (d *AccessMatrixDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+An access matrix establishes for every combination of users, objects and relations whether the user has the relation to the object, e.g. for access reviews. The checks are performed in batches.
+
+~> The matrix is only returned as a whole: if any check fails, e.g. because of an invalid user, the data source fails with the error of the first failed check. An object whose type is not defined in the authorization model fails the data source as well.
+`,
+
+		Attributes: map[string]schema.Attribute{
+			"store_id": schema.StringAttribute{
+				MarkdownDescription: "The unique ID of the OpenFGA store the checks are run against",
+				Required:            true,
+			},
+			"authorization_model_id": schema.StringAttribute{
+				MarkdownDescription: "The unique ID of the OpenFGA authorization model the checks are run against. Defaults to the latest authorization model of the store",
+				Optional:            true,
+				Computed:            true,
+			},
+			"users": schema.ListAttribute{
+				MarkdownDescription: "The users of the matrix",
+				ElementType:         types.StringType,
+				Required:            true,
+			},
+			"objects": schema.ListAttribute{
+				MarkdownDescription: "The objects of the matrix",
+				ElementType:         types.StringType,
+				Required:            true,
+			},
+			"relations": schema.ListAttribute{
+				MarkdownDescription: "The relations to check. Defaults to all relations of the type of each object. Relations which are not defined on the type of an object are not checked for it",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"max_batch_size": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of checks sent in a single BatchCheck request, between `1` and `50`, the default limit of the OpenFGA server. Defaults to `50`",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 50),
+				},
+			},
+			"max_parallel_requests": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of BatchCheck requests sent concurrently. Defaults to `10`",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"consistency": schema.StringAttribute{
+				MarkdownDescription: "The consistency preference of the checks, either `MINIMIZE_LATENCY` or `HIGHER_CONSISTENCY`. Defaults to the consistency preference of the provider",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(relationshiptuple.ConsistencyValues...),
+				},
+			},
+			"matrix": schema.MapAttribute{
+				MarkdownDescription: "The results of the checks, keyed by user, object and relation",
+				ElementType: types.MapType{
+					ElemType: types.MapType{
+						ElemType: types.BoolType,
+					},
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *AccessMatrixDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providerdata.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = NewAccessClient(providerData.Client, providerData.Consistency, providerData.QueryCache)
+}
+
+func (d *AccessMatrixDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state AccessMatrixDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	authorizationModelId, result, err := d.client.AccessMatrix(ctx, state.StoreId.ValueString(), state.AuthorizationModelId.ValueString(), state.AccessMatrixQueryModel, state.Consistency.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to compute access matrix, got error: %s", err))
+		return
+	}
+
+	state.AuthorizationModelId = types.StringValue(authorizationModelId)
+	state.AccessMatrixResultModel = *result
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,180 @@
+package access_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/openfga/terraform-provider-openfga/internal/provider/acceptance"
+)
+
+func TestAccAccessMatrixDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccAccessMatrixDataSourceConfig(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.openfga_access_matrix.all",
+						tfjsonpath.New("matrix"),
+						knownvalue.MapExact(map[string]knownvalue.Check{
+							"user:anne": knownvalue.MapExact(map[string]knownvalue.Check{
+								"document:1": knownvalue.MapExact(map[string]knownvalue.Check{
+									"parent": knownvalue.Bool(false),
+									"editor": knownvalue.Bool(true),
+									"viewer": knownvalue.Bool(true),
+								}),
+								"folder:x": knownvalue.MapExact(map[string]knownvalue.Check{
+									"viewer": knownvalue.Bool(false),
+								}),
+							}),
+							"user:bob": knownvalue.MapExact(map[string]knownvalue.Check{
+								"document:1": knownvalue.MapExact(map[string]knownvalue.Check{
+									"parent": knownvalue.Bool(false),
+									"editor": knownvalue.Bool(false),
+									"viewer": knownvalue.Bool(true),
+								}),
+								"folder:x": knownvalue.MapExact(map[string]knownvalue.Check{
+									"viewer": knownvalue.Bool(true),
+								}),
+							}),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"data.openfga_access_matrix.relations",
+						tfjsonpath.New("matrix"),
+						knownvalue.MapExact(map[string]knownvalue.Check{
+							"user:bob": knownvalue.MapExact(map[string]knownvalue.Check{
+								"document:1": knownvalue.MapExact(map[string]knownvalue.Check{
+									"editor": knownvalue.Bool(false),
+								}),
+								"folder:x": knownvalue.MapExact(map[string]knownvalue.Check{}),
+							}),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"data.openfga_access_matrix.relations",
+						tfjsonpath.New("authorization_model_id"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccAccessMatrixDataSourceInvalidBatchSize(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Batch size above the limit of the server testing
+			{
+				Config:      testAccAccessMatrixDataSourceInvalidBatchSizeConfig(),
+				ExpectError: regexp.MustCompile(`Attribute max_batch_size value must be between 1 and 50`),
+			},
+		},
+	})
+}
+
+func testAccAccessMatrixDataSourceInvalidBatchSizeConfig() string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "openfga_access_matrix" "test" {
+	store_id = "01ARZ3NDEKTSV4RRFFQ69G5FAV"
+
+	users   = ["user:anne"]
+	objects = ["document:1"]
+
+	max_batch_size = 51
+}
+`, acceptance.ProviderConfig)
+}
+
+func testAccAccessMatrixDataSourceConfig() string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "openfga_store" "test" {
+	name = "test"
+}
+
+data "openfga_authorization_model_document" "test" {
+	dsl = <<EOT
+model
+	schema 1.1
+
+type user
+
+type folder
+	relations
+		define viewer: [user]
+
+type document
+	relations
+		define parent: [folder]
+		define editor: [user]
+		define viewer: [user] or editor or viewer from parent
+	EOT
+}
+
+resource "openfga_authorization_model" "test" {
+	store_id = openfga_store.test.id
+
+	model_json = data.openfga_authorization_model_document.test.result
+}
+
+locals {
+	tuples = [
+		{ user = "user:anne", relation = "editor", object = "document:1" },
+		{ user = "user:bob", relation = "viewer", object = "folder:x" },
+		{ user = "folder:x", relation = "parent", object = "document:1" },
+	]
+}
+
+resource "openfga_relationship_tuple" "test" {
+	count = length(local.tuples)
+
+	store_id               = openfga_store.test.id
+	authorization_model_id = openfga_authorization_model.test.id
+
+	user     = local.tuples[count.index].user
+	relation = local.tuples[count.index].relation
+	object   = local.tuples[count.index].object
+}
+
+data "openfga_access_matrix" "all" {
+	depends_on = [openfga_relationship_tuple.test]
+
+	store_id               = openfga_store.test.id
+	authorization_model_id = openfga_authorization_model.test.id
+
+	users   = ["user:anne", "user:bob"]
+	objects = ["document:1", "folder:x"]
+
+	consistency = "HIGHER_CONSISTENCY"
+}
+
+data "openfga_access_matrix" "relations" {
+	depends_on = [openfga_relationship_tuple.test, openfga_authorization_model.test]
+
+	store_id = openfga_store.test.id
+
+	users     = ["user:bob"]
+	objects   = ["document:1", "folder:x"]
+	relations = ["editor"]
+
+	max_batch_size        = 1
+	max_parallel_requests = 1
+}
+`, acceptance.ProviderConfig)
+}
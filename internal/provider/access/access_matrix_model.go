@@ -0,0 +1,93 @@
+package access
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	defaultMaxBatchSize        = 50
+	defaultMaxParallelRequests = 10
+)
+
+type AccessMatrixQueryModel struct {
+	Users               []types.String  `tfsdk:"users"`
+	Objects             []types.String  `tfsdk:"objects"`
+	Relations           *[]types.String `tfsdk:"relations"`
+	MaxBatchSize        types.Int64     `tfsdk:"max_batch_size"`
+	MaxParallelRequests types.Int64     `tfsdk:"max_parallel_requests"`
+}
+
+func (model AccessMatrixQueryModel) GetUsers() []string {
+	return toStrings(model.Users)
+}
+
+func (model AccessMatrixQueryModel) GetObjects() []string {
+	return toStrings(model.Objects)
+}
+
+func (model AccessMatrixQueryModel) GetRelations() []string {
+	if model.Relations == nil {
+		return []string{}
+	}
+
+	return toStrings(*model.Relations)
+}
+
+func (model AccessMatrixQueryModel) GetMaxBatchSize() int32 {
+	if model.MaxBatchSize.IsNull() {
+		return defaultMaxBatchSize
+	}
+
+	return int32(model.MaxBatchSize.ValueInt64())
+}
+
+func (model AccessMatrixQueryModel) GetMaxParallelRequests() int32 {
+	if model.MaxParallelRequests.IsNull() {
+		return defaultMaxParallelRequests
+	}
+
+	return int32(model.MaxParallelRequests.ValueInt64())
+}
+
+// AccessMatrixCell is the result of checking a single relation of a user to
+// an object.
+type AccessMatrixCell struct {
+	User     string
+	Object   string
+	Relation string
+	Allowed  bool
+}
+
+type AccessMatrixResultModel struct {
+	Matrix map[string]map[string]map[string]types.Bool `tfsdk:"matrix"`
+}
+
+// NewAccessMatrixResultModel creates the matrix of the given users and objects
+// from the results of the checks. Every user and object is part of the matrix,
+// even if no relation was checked for them.
+func NewAccessMatrixResultModel(users []string, objects []string, cells []AccessMatrixCell) *AccessMatrixResultModel {
+	matrix := map[string]map[string]map[string]types.Bool{}
+	for _, user := range users {
+		matrix[user] = map[string]map[string]types.Bool{}
+		for _, object := range objects {
+			matrix[user][object] = map[string]types.Bool{}
+		}
+	}
+
+	for _, cell := range cells {
+		matrix[cell.User][cell.Object][cell.Relation] = types.BoolValue(cell.Allowed)
+	}
+
+	return &AccessMatrixResultModel{
+		Matrix: matrix,
+	}
+}
+
+func toStrings(values []types.String) []string {
+	result := []string{}
+	for _, value := range values {
+		result = append(result, value.ValueString())
+	}
+
+	return result
+}
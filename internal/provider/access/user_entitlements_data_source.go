@@ -0,0 +1,150 @@
+package access
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/openfga/terraform-provider-openfga/internal/provider/providerdata"
+	"github.com/openfga/terraform-provider-openfga/internal/provider/relationshiptuple"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UserEntitlementsDataSource{}
+var _ datasource.DataSourceWithConfigure = &UserEntitlementsDataSource{}
+
+func NewUserEntitlementsDataSource() datasource.DataSource {
+	return &UserEntitlementsDataSource{}
+}
+
+type UserEntitlementsDataSource struct {
+	client *AccessClient
+}
+
+type UserEntitlementsDataSourceModel struct {
+	StoreId              types.String `tfsdk:"store_id"`
+	AuthorizationModelId types.String `tfsdk:"authorization_model_id"`
+
+	UserEntitlementsQueryModel
+	relationshiptuple.ConsistencyModel
+
+	UserEntitlementsResultModel
+}
+
+func (d *UserEntitlementsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_entitlements"
+}
+
+func (d *UserEntitlementsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The entitlements of a user are all objects the user has any relation to, e.g. for off-boarding or audits. A 'list objects' query is performed for every relation of every type of the authorization model.",
+
+		Attributes: map[string]schema.Attribute{
+			"store_id": schema.StringAttribute{
+				MarkdownDescription: "The unique ID of the OpenFGA store the queries are run against",
+				Required:            true,
+			},
+			"authorization_model_id": schema.StringAttribute{
+				MarkdownDescription: "The unique ID of the OpenFGA authorization model the queries are run against. Defaults to the latest authorization model of the store",
+				Optional:            true,
+				Computed:            true,
+			},
+			"user": schema.StringAttribute{
+				MarkdownDescription: "The user whose entitlements are listed",
+				Required:            true,
+			},
+			"max_results": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of objects to return per type and relation. All objects are returned if not set",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"timeout": schema.StringAttribute{
+				MarkdownDescription: "The maximum duration of each query, e.g. `30s` or `2m`. The objects received until then are returned. No timeout applies if not set",
+				Optional:            true,
+			},
+			"max_parallel_requests": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of queries performed concurrently. Defaults to `10`",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"consistency": schema.StringAttribute{
+				MarkdownDescription: "The consistency preference of the queries, either `MINIMIZE_LATENCY` or `HIGHER_CONSISTENCY`. Defaults to the consistency preference of the provider",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(relationshiptuple.ConsistencyValues...),
+				},
+			},
+			"entitlements": schema.MapAttribute{
+				MarkdownDescription: "The objects the user has a relation to, keyed by type and relation. Each entry holds the `objects` and whether they were `truncated` by `max_results` or `timeout`",
+				ElementType: types.MapType{
+					ElemType: types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"objects":   types.SetType{ElemType: types.StringType},
+							"truncated": types.BoolType,
+						},
+					},
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *UserEntitlementsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providerdata.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = NewAccessClient(providerData.Client, providerData.Consistency, providerData.QueryCache)
+}
+
+func (d *UserEntitlementsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state UserEntitlementsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, err := state.GetTimeout()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("timeout"), "Invalid Timeout", fmt.Sprintf("Unable to parse timeout, got error: %s", err))
+		return
+	}
+
+	authorizationModelId, result, err := d.client.UserEntitlements(ctx, state.StoreId.ValueString(), state.AuthorizationModelId.ValueString(), state.GetUser(), state.Consistency.ValueString(), state.GetMaxResults(), timeout, state.GetMaxParallelRequests())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list user entitlements, got error: %s", err))
+		return
+	}
+
+	state.AuthorizationModelId = types.StringValue(authorizationModelId)
+	state.UserEntitlementsResultModel = *result
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,158 @@
+package access_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/openfga/terraform-provider-openfga/internal/provider/acceptance"
+)
+
+func TestAccUserEntitlementsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccUserEntitlementsDataSourceConfig(`
+	max_parallel_requests = 2
+	consistency           = "HIGHER_CONSISTENCY"
+`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.openfga_user_entitlements.test",
+						tfjsonpath.New("entitlements"),
+						knownvalue.MapExact(map[string]knownvalue.Check{
+							"user": knownvalue.MapExact(map[string]knownvalue.Check{}),
+							"folder": knownvalue.MapExact(map[string]knownvalue.Check{
+								"viewer": knownvalue.ObjectExact(map[string]knownvalue.Check{
+									"objects": knownvalue.SetExact([]knownvalue.Check{
+										knownvalue.StringExact("folder:x"),
+									}),
+									"truncated": knownvalue.Bool(false),
+								}),
+							}),
+							"document": knownvalue.MapExact(map[string]knownvalue.Check{
+								"parent": knownvalue.ObjectExact(map[string]knownvalue.Check{
+									"objects":   knownvalue.SetExact([]knownvalue.Check{}),
+									"truncated": knownvalue.Bool(false),
+								}),
+								"editor": knownvalue.ObjectExact(map[string]knownvalue.Check{
+									"objects": knownvalue.SetExact([]knownvalue.Check{
+										knownvalue.StringExact("document:2"),
+									}),
+									"truncated": knownvalue.Bool(false),
+								}),
+								"viewer": knownvalue.ObjectExact(map[string]knownvalue.Check{
+									"objects": knownvalue.SetExact([]knownvalue.Check{
+										knownvalue.StringExact("document:1"),
+										knownvalue.StringExact("document:2"),
+									}),
+									"truncated": knownvalue.Bool(false),
+								}),
+							}),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"data.openfga_user_entitlements.test",
+						tfjsonpath.New("authorization_model_id"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+			{
+				Config: testAccUserEntitlementsDataSourceConfig(`
+	max_results = 1
+`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.openfga_user_entitlements.test",
+						tfjsonpath.New("entitlements").AtMapKey("document").AtMapKey("viewer").AtMapKey("objects"),
+						knownvalue.SetSizeExact(1),
+					),
+					statecheck.ExpectKnownValue(
+						"data.openfga_user_entitlements.test",
+						tfjsonpath.New("entitlements").AtMapKey("document").AtMapKey("viewer").AtMapKey("truncated"),
+						knownvalue.Bool(true),
+					),
+				},
+			},
+			{
+				Config: testAccUserEntitlementsDataSourceConfig(`
+	timeout = "invalid"
+`),
+				ExpectError: regexp.MustCompile("Invalid Timeout"),
+			},
+		},
+	})
+}
+
+func testAccUserEntitlementsDataSourceConfig(options string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "openfga_store" "test" {
+	name = "test"
+}
+
+data "openfga_authorization_model_document" "test" {
+	dsl = <<EOT
+model
+	schema 1.1
+
+type user
+
+type folder
+	relations
+		define viewer: [user]
+
+type document
+	relations
+		define parent: [folder]
+		define editor: [user]
+		define viewer: [user] or editor or viewer from parent
+	EOT
+}
+
+resource "openfga_authorization_model" "test" {
+	store_id = openfga_store.test.id
+
+	model_json = data.openfga_authorization_model_document.test.result
+}
+
+locals {
+	tuples = [
+		{ user = "user:anne", relation = "viewer", object = "folder:x" },
+		{ user = "folder:x", relation = "parent", object = "document:1" },
+		{ user = "user:anne", relation = "editor", object = "document:2" },
+	]
+}
+
+resource "openfga_relationship_tuple" "test" {
+	count = length(local.tuples)
+
+	store_id               = openfga_store.test.id
+	authorization_model_id = openfga_authorization_model.test.id
+
+	user     = local.tuples[count.index].user
+	relation = local.tuples[count.index].relation
+	object   = local.tuples[count.index].object
+}
+
+data "openfga_user_entitlements" "test" {
+	depends_on = [openfga_relationship_tuple.test]
+
+	store_id               = openfga_store.test.id
+	authorization_model_id = openfga_authorization_model.test.id
+
+	user = "user:anne"
+%[2]s
+}
+`, acceptance.ProviderConfig, options)
+}
@@ -0,0 +1,60 @@
+package access
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/openfga/terraform-provider-openfga/internal/provider/query"
+)
+
+type UserEntitlementsQueryModel struct {
+	User                types.String `tfsdk:"user"`
+	MaxResults          types.Int64  `tfsdk:"max_results"`
+	Timeout             types.String `tfsdk:"timeout"`
+	MaxParallelRequests types.Int64  `tfsdk:"max_parallel_requests"`
+}
+
+func (model UserEntitlementsQueryModel) GetUser() string {
+	return model.User.ValueString()
+}
+
+func (model UserEntitlementsQueryModel) GetMaxResults() int {
+	if model.MaxResults.IsNull() {
+		return 0
+	}
+
+	return int(model.MaxResults.ValueInt64())
+}
+
+func (model UserEntitlementsQueryModel) GetTimeout() (time.Duration, error) {
+	if model.Timeout.IsNull() {
+		return 0, nil
+	}
+
+	return time.ParseDuration(model.Timeout.ValueString())
+}
+
+func (model UserEntitlementsQueryModel) GetMaxParallelRequests() int {
+	if model.MaxParallelRequests.IsNull() {
+		return defaultMaxParallelRequests
+	}
+
+	return int(model.MaxParallelRequests.ValueInt64())
+}
+
+type UserEntitlementModel struct {
+	Objects   types.Set  `tfsdk:"objects"`
+	Truncated types.Bool `tfsdk:"truncated"`
+}
+
+func NewUserEntitlementModel(result query.ListObjectsResultModel) *UserEntitlementModel {
+	return &UserEntitlementModel{
+		Objects:   result.Result,
+		Truncated: result.Truncated,
+	}
+}
+
+type UserEntitlementsResultModel struct {
+	Entitlements map[string]map[string]UserEntitlementModel `tfsdk:"entitlements"`
+}
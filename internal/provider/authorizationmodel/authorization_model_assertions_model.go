@@ -0,0 +1,65 @@
+package authorizationmodel
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	openfga "github.com/openfga/go-sdk"
+
+	"github.com/openfga/terraform-provider-openfga/internal/provider/relationshiptuple"
+)
+
+type AssertionModel struct {
+	relationshiptuple.RelationshipTupleModel
+	ContextualTuples *[]relationshiptuple.RelationshipTupleWithConditionModel `tfsdk:"contextual_tuples"`
+	relationshiptuple.ContextModel
+	Expectation types.Bool `tfsdk:"expectation"`
+}
+
+func (model AssertionModel) GetContextualTuples() []relationshiptuple.RelationshipTupleWithConditionModel {
+	if model.ContextualTuples == nil {
+		return []relationshiptuple.RelationshipTupleWithConditionModel{}
+	}
+
+	return *model.ContextualTuples
+}
+
+func (model AssertionModel) GetExpectation() bool {
+	return model.Expectation.ValueBool()
+}
+
+func NewAssertionModelFromAssertion(assertion openfga.Assertion) *AssertionModel {
+	var contextualTuples *[]relationshiptuple.RelationshipTupleWithConditionModel
+	if assertion.ContextualTuples != nil && len(*assertion.ContextualTuples) > 0 {
+		contextualTupleModels := []relationshiptuple.RelationshipTupleWithConditionModel{}
+		for _, contextualTuple := range *assertion.ContextualTuples {
+			contextualTupleModels = append(
+				contextualTupleModels,
+				*relationshiptuple.NewRelationshipTupleWithConditionModelFromTuple(&contextualTuple),
+			)
+		}
+
+		contextualTuples = &contextualTupleModels
+	}
+
+	return &AssertionModel{
+		RelationshipTupleModel: *relationshiptuple.NewRelationshipTupleModel(assertion.TupleKey.User, assertion.TupleKey.Relation, assertion.TupleKey.Object),
+		ContextualTuples:       contextualTuples,
+		ContextModel:           *relationshiptuple.NewContextModel(assertion.Context),
+		Expectation:            types.BoolValue(assertion.Expectation),
+	}
+}
+
+type AuthorizationModelAssertionsModel struct {
+	AuthorizationModelId types.String     `tfsdk:"authorization_model_id"`
+	Assertions           []AssertionModel `tfsdk:"assertions"`
+}
+
+func (model AuthorizationModelAssertionsModel) GetAuthorizationModelId() string {
+	return model.AuthorizationModelId.ValueString()
+}
+
+func NewAuthorizationModelAssertionsModel(authorizationModelId string, assertions []AssertionModel) *AuthorizationModelAssertionsModel {
+	return &AuthorizationModelAssertionsModel{
+		AuthorizationModelId: types.StringValue(authorizationModelId),
+		Assertions:           assertions,
+	}
+}
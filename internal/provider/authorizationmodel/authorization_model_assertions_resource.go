@@ -0,0 +1,260 @@
+package authorizationmodel
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	internalError "github.com/openfga/terraform-provider-openfga/internal/apierror"
+	"github.com/openfga/terraform-provider-openfga/internal/provider/providerdata"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AuthorizationModelAssertionsResource{}
+var _ resource.ResourceWithImportState = &AuthorizationModelAssertionsResource{}
+
+func NewAuthorizationModelAssertionsResource() resource.Resource {
+	return &AuthorizationModelAssertionsResource{}
+}
+
+type AuthorizationModelAssertionsResource struct {
+	client *AuthorizationModelClient
+}
+
+type AuthorizationModelAssertionsResourceModel struct {
+	StoreId types.String `tfsdk:"store_id"`
+	AuthorizationModelAssertionsModel
+}
+
+func (r *AuthorizationModelAssertionsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_authorization_model_assertions"
+}
+
+func (r *AuthorizationModelAssertionsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Provides the ability to manage the assertions of an OpenFGA authorization model.
+
+An assertion consists of a check query (user, relation and object, optionally with contextual tuples and context) together with the expected result. Assertions are stored per authorization model and can be used to verify that a model behaves as intended.
+
+~> OpenFGA stores all assertions of an authorization model as a single set. This resource manages the complete set, so only one ` + "`openfga_authorization_model_assertions`" + ` resource should be defined per authorization model.
+`,
+
+		Attributes: map[string]schema.Attribute{
+			"store_id": schema.StringAttribute{
+				MarkdownDescription: "The unique ID of the store the authorization model belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"authorization_model_id": schema.StringAttribute{
+				MarkdownDescription: "The unique ID of the authorization model the assertions belong to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"assertions": schema.ListNestedAttribute{
+				MarkdownDescription: "The assertions of the authorization model.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: AssertionSchema(),
+				},
+			},
+		},
+	}
+}
+
+func AssertionSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"user": schema.StringAttribute{
+			MarkdownDescription: "The user of the asserted check query.",
+			Required:            true,
+		},
+		"relation": schema.StringAttribute{
+			MarkdownDescription: "The relation of the asserted check query.",
+			Required:            true,
+		},
+		"object": schema.StringAttribute{
+			MarkdownDescription: "The object of the asserted check query.",
+			Required:            true,
+		},
+		"expectation": schema.BoolAttribute{
+			MarkdownDescription: "The expected result of the check query.",
+			Required:            true,
+		},
+		"contextual_tuples": schema.ListNestedAttribute{
+			MarkdownDescription: "The contextual tuples that should be considered for the check query.",
+			Optional:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"user": schema.StringAttribute{
+						MarkdownDescription: "The user of the contextual relationship tuple.",
+						Required:            true,
+					},
+					"relation": schema.StringAttribute{
+						MarkdownDescription: "The relation of the contextual relationship tuple.",
+						Required:            true,
+					},
+					"object": schema.StringAttribute{
+						MarkdownDescription: "The object of the contextual relationship tuple.",
+						Required:            true,
+					},
+					"condition": schema.SingleNestedAttribute{
+						MarkdownDescription: "A condition of the contextual relationship tuple.",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"name": schema.StringAttribute{
+								MarkdownDescription: "The name of the condition.",
+								Required:            true,
+							},
+							"context_json": schema.StringAttribute{
+								MarkdownDescription: "The (partial) context under which the condition is evaluated.",
+								CustomType:          jsontypes.NormalizedType{},
+								Optional:            true,
+							},
+						},
+					},
+				},
+			},
+		},
+		"context_json": schema.StringAttribute{
+			MarkdownDescription: "The (partial) context under which the condition is evaluated.",
+			CustomType:          jsontypes.NormalizedType{},
+			Optional:            true,
+		},
+	}
+}
+
+func (r *AuthorizationModelAssertionsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providerdata.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = NewAuthorizationModelClient(providerData.Client)
+}
+
+func (r *AuthorizationModelAssertionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var state AuthorizationModelAssertionsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	authorizationModelAssertionsModel, err := r.client.WriteAssertions(ctx, state.StoreId.ValueString(), state.AuthorizationModelAssertionsModel)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create authorization model assertions, got error: %s", err))
+		return
+	}
+
+	state.AuthorizationModelAssertionsModel = *authorizationModelAssertionsModel
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *AuthorizationModelAssertionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state AuthorizationModelAssertionsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	authorizationModelAssertionsModel, err := r.client.ReadAssertions(ctx, state.StoreId.ValueString(), state.AuthorizationModelAssertionsModel)
+	if err != nil {
+		if internalError.IsStatusNotFound(err) {
+			resp.Diagnostics.AddWarning(
+				"Authorization model not found",
+				fmt.Sprintf("Authorization model %q no longer exists; removing assertions from state.", state.AuthorizationModelId.ValueString()),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read authorization model assertions, got error: %s", err))
+		return
+	}
+
+	state.AuthorizationModelAssertionsModel = *authorizationModelAssertionsModel
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *AuthorizationModelAssertionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state AuthorizationModelAssertionsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	authorizationModelAssertionsModel, err := r.client.WriteAssertions(ctx, state.StoreId.ValueString(), state.AuthorizationModelAssertionsModel)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update authorization model assertions, got error: %s", err))
+		return
+	}
+
+	state.AuthorizationModelAssertionsModel = *authorizationModelAssertionsModel
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *AuthorizationModelAssertionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state AuthorizationModelAssertionsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteAssertions(ctx, state.StoreId.ValueString(), state.AuthorizationModelAssertionsModel)
+	if err != nil {
+		if internalError.IsStatusNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete authorization model assertions, got error: %s", err))
+		return
+	}
+}
+
+func (r *AuthorizationModelAssertionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError("Input Error", fmt.Sprintf("Input ID has to be in the format of <store_id>/<authorization_model_id>, but received: %s", req.ID))
+		return
+	}
+
+	state := AuthorizationModelAssertionsResourceModel{
+		StoreId:                           types.StringValue(parts[0]),
+		AuthorizationModelAssertionsModel: *NewAuthorizationModelAssertionsModel(parts[1], []AssertionModel{}),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
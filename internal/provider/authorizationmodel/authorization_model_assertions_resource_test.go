@@ -0,0 +1,158 @@
+package authorizationmodel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/openfga/terraform-provider-openfga/internal/provider/acceptance"
+)
+
+func TestAccAuthorizationModelAssertionsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccAuthorizationModelAssertionsResourceConfig(true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"openfga_authorization_model_assertions.test",
+						tfjsonpath.New("assertions"),
+						knownvalue.ListSizeExact(2),
+					),
+					statecheck.ExpectKnownValue(
+						"openfga_authorization_model_assertions.test",
+						tfjsonpath.New("assertions").AtSliceIndex(0).AtMapKey("expectation"),
+						knownvalue.Bool(true),
+					),
+					statecheck.ExpectKnownValue(
+						"openfga_authorization_model_assertions.test",
+						tfjsonpath.New("assertions").AtSliceIndex(1).AtMapKey("contextual_tuples"),
+						knownvalue.ListSizeExact(1),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "openfga_authorization_model_assertions.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "authorization_model_id",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					store, ok := s.RootModule().Resources["openfga_store.test"]
+					if !ok {
+						return "", fmt.Errorf("Unable to find resource openfga_store.test")
+					}
+
+					authorizationModel, ok := s.RootModule().Resources["openfga_authorization_model.test"]
+					if !ok {
+						return "", fmt.Errorf("Unable to find resource openfga_authorization_model.test")
+					}
+
+					return fmt.Sprintf(
+						"%s/%s",
+						store.Primary.Attributes["id"],
+						authorizationModel.Primary.Attributes["id"],
+					), nil
+				},
+			},
+			// Update and Read testing
+			{
+				Config: testAccAuthorizationModelAssertionsResourceConfig(false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							"openfga_authorization_model_assertions.test",
+							plancheck.ResourceActionUpdate,
+						),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"openfga_authorization_model_assertions.test",
+						tfjsonpath.New("assertions").AtSliceIndex(0).AtMapKey("expectation"),
+						knownvalue.Bool(false),
+					),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccAuthorizationModelAssertionsResourceConfig(expectation bool) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "openfga_store" "test" {
+	name = "test"
+}
+
+data "openfga_authorization_model_document" "test" {
+	dsl = <<EOT
+model
+	schema 1.1
+
+type user
+
+type document
+	relations
+		define viewer: [user, user with larger_than]
+
+condition larger_than(required: int, provided: int) {
+	provided > required
+}
+	EOT
+}
+
+resource "openfga_authorization_model" "test" {
+	store_id = openfga_store.test.id
+
+	model_json = data.openfga_authorization_model_document.test.result
+}
+
+resource "openfga_authorization_model_assertions" "test" {
+	store_id               = openfga_store.test.id
+	authorization_model_id = openfga_authorization_model.test.id
+
+	assertions = [
+		{
+			user        = "user:user-1"
+			relation    = "viewer"
+			object      = "document:document-1"
+			expectation = %[2]t
+		},
+		{
+			user        = "user:user-2"
+			relation    = "viewer"
+			object      = "document:document-1"
+			expectation = true
+
+			contextual_tuples = [{
+				user      = "user:user-2"
+				relation  = "viewer"
+				object    = "document:document-1"
+				condition = {
+					name = "larger_than"
+					context_json = jsonencode({
+						provided = 100
+					})
+				}
+			}]
+
+			context_json = jsonencode({
+				required = 50
+			})
+		},
+	]
+}
+`, acceptance.ProviderConfig, expectation)
+}
@@ -119,3 +119,89 @@ func (wrapper *AuthorizationModelClient) ListAuthorizationModels(ctx context.Con
 
 	return &authorizationModelModels, nil
 }
+
+func (model AssertionModel) ToClientAssertion() (*client.ClientAssertion, error) {
+	context, err := model.GetContextMap()
+	if err != nil {
+		return nil, err
+	}
+
+	contextualTuples := []client.ClientContextualTupleKey{}
+	for _, contextualTupleModel := range model.GetContextualTuples() {
+		contextualTuple, err := contextualTupleModel.ToTupleWithCondition()
+		if err != nil {
+			return nil, err
+		}
+
+		contextualTuples = append(contextualTuples, *contextualTuple)
+	}
+
+	return &client.ClientAssertion{
+		User:             model.GetUser(),
+		Relation:         model.GetRelation(),
+		Object:           model.GetObject(),
+		Expectation:      model.GetExpectation(),
+		Context:          context,
+		ContextualTuples: contextualTuples,
+	}, nil
+}
+
+func (model AuthorizationModelAssertionsModel) ToWriteAssertionsRequest() (*client.ClientWriteAssertionsRequest, error) {
+	assertions := client.ClientWriteAssertionsRequest{}
+	for _, assertionModel := range model.Assertions {
+		assertion, err := assertionModel.ToClientAssertion()
+		if err != nil {
+			return nil, err
+		}
+
+		assertions = append(assertions, *assertion)
+	}
+
+	return &assertions, nil
+}
+
+func (wrapper *AuthorizationModelClient) WriteAssertions(ctx context.Context, storeId string, model AuthorizationModelAssertionsModel) (*AuthorizationModelAssertionsModel, error) {
+	options := client.ClientWriteAssertionsOptions{
+		StoreId:              openfga.PtrString(storeId),
+		AuthorizationModelId: openfga.PtrString(model.GetAuthorizationModelId()),
+	}
+
+	body, err := model.ToWriteAssertionsRequest()
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = wrapper.client.WriteAssertions(ctx).Options(options).Body(*body).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	authorizationModelAssertionsModel := model
+
+	return &authorizationModelAssertionsModel, nil
+}
+
+func (wrapper *AuthorizationModelClient) ReadAssertions(ctx context.Context, storeId string, model AuthorizationModelAssertionsModel) (*AuthorizationModelAssertionsModel, error) {
+	options := client.ClientReadAssertionsOptions{
+		StoreId:              openfga.PtrString(storeId),
+		AuthorizationModelId: openfga.PtrString(model.GetAuthorizationModelId()),
+	}
+
+	response, err := wrapper.client.ReadAssertions(ctx).Options(options).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	assertionModels := []AssertionModel{}
+	for _, assertion := range response.GetAssertions() {
+		assertionModels = append(assertionModels, *NewAssertionModelFromAssertion(assertion))
+	}
+
+	return NewAuthorizationModelAssertionsModel(model.GetAuthorizationModelId(), assertionModels), nil
+}
+
+func (wrapper *AuthorizationModelClient) DeleteAssertions(ctx context.Context, storeId string, model AuthorizationModelAssertionsModel) error {
+	_, err := wrapper.WriteAssertions(ctx, storeId, *NewAuthorizationModelAssertionsModel(model.GetAuthorizationModelId(), []AssertionModel{}))
+
+	return err
+}
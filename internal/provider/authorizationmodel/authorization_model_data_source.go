@@ -9,7 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
-	"github.com/openfga/go-sdk/client"
+	"github.com/openfga/terraform-provider-openfga/internal/provider/providerdata"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -61,18 +61,18 @@ func (d *AuthorizationModelDataSource) Configure(ctx context.Context, req dataso
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.OpenFgaClient)
+	providerData, ok := req.ProviderData.(*providerdata.ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.OpenFgaClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	d.client = NewAuthorizationModelClient(client)
+	d.client = NewAuthorizationModelClient(providerData.Client)
 }
 
 func (d *AuthorizationModelDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
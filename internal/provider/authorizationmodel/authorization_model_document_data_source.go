@@ -143,6 +143,49 @@ func extractAuthorizationModelProto(state *AuthorizationModelDocumentDataSourceM
 	return nil, fmt.Errorf("at least one of model, mod file path, DSL or JSON has to be provided")
 }
 
+// TransformModelFileToJson reads an authorization model from a file in DSL,
+// JSON or mod file format (determined by its extension) and returns it in a stable JSON format.
+func TransformModelFileToJson(modelFilePath string) (string, error) {
+	var modelProto *openfgav1.AuthorizationModel
+	var err error
+
+	switch filepath.Ext(modelFilePath) {
+	case ".mod":
+		modelProto, err = parseModFileToAuthorizationModelProto(modelFilePath)
+	case ".json":
+		var jsonBytes []byte
+		jsonBytes, err = os.ReadFile(modelFilePath)
+		if err != nil {
+			return "", fmt.Errorf("unable to read model file, got error: %s", err)
+		}
+
+		modelProto, err = parseJsonToAuthorizationModelProto(string(jsonBytes))
+	default:
+		var dslBytes []byte
+		dslBytes, err = os.ReadFile(modelFilePath)
+		if err != nil {
+			return "", fmt.Errorf("unable to read model file, got error: %s", err)
+		}
+
+		modelProto, err = parseDslToAuthorizationModelProto(string(dslBytes))
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return marshalToSanitizedJson(modelProto)
+}
+
+// TransformDslToJson converts an authorization model in DSL format into a stable JSON format.
+func TransformDslToJson(dsl string) (string, error) {
+	modelProto, err := parseDslToAuthorizationModelProto(dsl)
+	if err != nil {
+		return "", err
+	}
+
+	return marshalToSanitizedJson(modelProto)
+}
+
 func parseModelToAuthorizationModelProto(model *CustomAuthorizationModel) (*openfgav1.AuthorizationModel, error) {
 	jsonBytes, err := json.Marshal(model)
 	if err != nil {
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -57,6 +58,28 @@ func (model AuthorizationModelModel) ToAuthorizationModel() (*openfga.Authorizat
 	return &authorizationModel, nil
 }
 
+// GetRelations returns the relations of every type of the authorization model,
+// keyed by type and sorted by name.
+func (model AuthorizationModelModel) GetRelations() (map[string][]string, error) {
+	authorizationModel, err := model.ToAuthorizationModel()
+	if err != nil {
+		return nil, err
+	}
+
+	relations := map[string][]string{}
+	for _, typeDefinition := range authorizationModel.GetTypeDefinitions() {
+		typeRelations := []string{}
+		for relation := range typeDefinition.GetRelations() {
+			typeRelations = append(typeRelations, relation)
+		}
+		sort.Strings(typeRelations)
+
+		relations[typeDefinition.GetType()] = typeRelations
+	}
+
+	return relations, nil
+}
+
 func NewAuthorizationModelModel(id string) *AuthorizationModelModel {
 	return &AuthorizationModelModel{
 		Id:        types.StringValue(id),
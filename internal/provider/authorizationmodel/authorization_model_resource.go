@@ -12,7 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
-	"github.com/openfga/go-sdk/client"
+	"github.com/openfga/terraform-provider-openfga/internal/provider/providerdata"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -78,18 +78,18 @@ func (r *AuthorizationModelResource) Configure(ctx context.Context, req resource
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.OpenFgaClient)
+	providerData, ok := req.ProviderData.(*providerdata.ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.OpenFgaClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = NewAuthorizationModelClient(client)
+	r.client = NewAuthorizationModelClient(providerData.Client)
 }
 
 func (r *AuthorizationModelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
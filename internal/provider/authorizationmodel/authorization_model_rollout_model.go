@@ -0,0 +1,55 @@
+package authorizationmodel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/openfga/terraform-provider-openfga/internal/provider/query"
+)
+
+type AuthorizationModelRolloutModel struct {
+	StoreId          types.String         `tfsdk:"store_id"`
+	ModelJson        jsontypes.Normalized `tfsdk:"model_json"`
+	Assertions       []AssertionModel     `tfsdk:"assertions"`
+	CandidateModelId types.String         `tfsdk:"candidate_model_id"`
+	ActiveModelId    types.String         `tfsdk:"active_model_id"`
+	PreviousModelId  types.String         `tfsdk:"previous_model_id"`
+}
+
+func (model AuthorizationModelRolloutModel) GetStoreId() string {
+	return model.StoreId.ValueString()
+}
+
+func (model AuthorizationModelRolloutModel) GetActiveModelId() string {
+	return model.ActiveModelId.ValueString()
+}
+
+func (model AssertionModel) ToCheckQueryModel() *query.CheckQueryModel {
+	return &query.CheckQueryModel{
+		RelationshipTupleModel: model.RelationshipTupleModel,
+		ContextualTuples:       model.ContextualTuples,
+		ContextModel:           model.ContextModel,
+	}
+}
+
+// RunAssertions runs the given assertions as check queries against an
+// authorization model and returns a description of every failed assertion.
+func RunAssertions(ctx context.Context, queryClient *query.QueryClient, storeId string, authorizationModelId string, assertions []AssertionModel) ([]string, error) {
+	failures := []string{}
+
+	for _, assertion := range assertions {
+		result, err := queryClient.Check(ctx, storeId, authorizationModelId, *assertion.ToCheckQueryModel(), "")
+		if err != nil {
+			return nil, err
+		}
+
+		if result.ValueBool() != assertion.GetExpectation() {
+			failures = append(failures, fmt.Sprintf("check(user=%s, relation=%s, object=%s): expected %t, got %t", assertion.GetUser(), assertion.GetRelation(), assertion.GetObject(), assertion.GetExpectation(), result.ValueBool()))
+		}
+	}
+
+	return failures, nil
+}
@@ -0,0 +1,323 @@
+package authorizationmodel
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	internalError "github.com/openfga/terraform-provider-openfga/internal/apierror"
+	"github.com/openfga/terraform-provider-openfga/internal/provider/providerdata"
+	"github.com/openfga/terraform-provider-openfga/internal/provider/query"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AuthorizationModelRolloutResource{}
+var _ resource.ResourceWithImportState = &AuthorizationModelRolloutResource{}
+var _ resource.ResourceWithModifyPlan = &AuthorizationModelRolloutResource{}
+
+func NewAuthorizationModelRolloutResource() resource.Resource {
+	return &AuthorizationModelRolloutResource{}
+}
+
+type AuthorizationModelRolloutResource struct {
+	client      *AuthorizationModelClient
+	queryClient *query.QueryClient
+}
+
+func (r *AuthorizationModelRolloutResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_authorization_model_rollout"
+}
+
+func (r *AuthorizationModelRolloutResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Provides the ability to roll out OpenFGA authorization models, gated by a set of assertions.
+
+Whenever ` + "`model_json`" + ` changes, the model is written as a new candidate and the assertions are run as check queries against it, using the relationship tuples of the store. Only if all assertions pass, the candidate is promoted and ` + "`active_model_id`" + ` switches to the new model. Otherwise an error is raised and ` + "`active_model_id`" + ` keeps referring to the previously active model.
+
+Services should read ` + "`active_model_id`" + `, so that a model that breaks known access never reaches them.
+
+!> The candidate model is written before the assertions are run, so it becomes the **latest** authorization model of the store even if the assertions fail. Clients which do not pin an authorization model ID, as well as ` + "`openfga_relationship_tuple`" + ` resources and other resources and data sources without an ` + "`authorization_model_id`" + `, use the latest model and are therefore affected by a failed candidate immediately. Pin ` + "`active_model_id`" + ` wherever an authorization model ID can be given.
+
+~> Authorization models cannot be deleted in OpenFGA. Candidate models that fail their assertions remain in the store, but are never promoted.
+`,
+
+		Attributes: map[string]schema.Attribute{
+			"store_id": schema.StringAttribute{
+				MarkdownDescription: "The unique ID of the store the authorization model is rolled out to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"model_json": schema.StringAttribute{
+				MarkdownDescription: "The authorization model definition in JSON format. Consider using [`openfga_authorization_model_document`](../data-sources/authorization_model_document) to set this field.",
+				Required:            true,
+				CustomType:          jsontypes.NormalizedType{},
+			},
+			"assertions": schema.ListNestedAttribute{
+				MarkdownDescription: "The assertions a candidate model has to pass before it is promoted.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: AssertionSchema(),
+				},
+			},
+			"candidate_model_id": schema.StringAttribute{
+				MarkdownDescription: "The unique ID of the most recently written candidate model.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"active_model_id": schema.StringAttribute{
+				MarkdownDescription: "The unique ID of the authorization model that passed its assertions and is active.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"previous_model_id": schema.StringAttribute{
+				MarkdownDescription: "The unique ID of the authorization model that was active before the current one.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *AuthorizationModelRolloutResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providerdata.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = NewAuthorizationModelClient(providerData.Client)
+	r.queryClient = query.NewQueryClient(providerData.Client, providerData.Consistency, nil)
+}
+
+func (r *AuthorizationModelRolloutResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on create and destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state AuthorizationModelRolloutModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modelJsonEqual, diags := plan.ModelJson.StringSemanticEquals(ctx, state.ModelJson)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() || modelJsonEqual {
+		return
+	}
+
+	// A changed model results in a new candidate, which might get promoted
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("candidate_model_id"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("active_model_id"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("previous_model_id"), types.StringUnknown())...)
+}
+
+// rollout writes the planned model as a new candidate and promotes it if all
+// assertions pass. If the assertions fail, the returned state keeps the
+// previously active model together with the failed candidate.
+// On create, the failed state must not be stored, as no model is active yet.
+func (r *AuthorizationModelRolloutResource) rollout(ctx context.Context, plan AuthorizationModelRolloutModel, state *AuthorizationModelRolloutModel) (*AuthorizationModelRolloutModel, []string, error) {
+	authorizationModelModel, err := r.client.CreateAuthorizationModel(ctx, plan.GetStoreId(), *NewAuthorizationModelModelWithModelJson("", plan.ModelJson.ValueString()))
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to create candidate authorization model, got error: %s", err)
+	}
+
+	candidateModelId := authorizationModelModel.GetId()
+
+	failures, err := RunAssertions(ctx, r.queryClient, plan.GetStoreId(), candidateModelId, plan.Assertions)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to run assertions against candidate authorization model %q, which is now the latest authorization model of the store, got error: %s", candidateModelId, err)
+	}
+
+	if len(failures) > 0 {
+		failedState := plan
+		if state != nil {
+			failedState = *state
+		}
+		failedState.CandidateModelId = types.StringValue(candidateModelId)
+
+		return &failedState, failures, nil
+	}
+
+	promotedState := plan
+	promotedState.CandidateModelId = types.StringValue(candidateModelId)
+	promotedState.ActiveModelId = types.StringValue(candidateModelId)
+	promotedState.PreviousModelId = types.StringNull()
+	if state != nil {
+		promotedState.PreviousModelId = state.ActiveModelId
+	}
+
+	return &promotedState, nil, nil
+}
+
+func addAssertionFailures(diagnostics *diag.Diagnostics, candidateModelId string, failures []string) {
+	for _, failure := range failures {
+		diagnostics.AddError("Assertion Failed", fmt.Sprintf("Candidate authorization model %q was not promoted, but is now the latest authorization model of the store: %s", candidateModelId, failure))
+	}
+}
+
+func (r *AuthorizationModelRolloutResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan AuthorizationModelRolloutModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, failures, err := r.rollout(ctx, plan, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to roll out authorization model, got error: %s", err))
+		return
+	}
+
+	if len(failures) > 0 {
+		addAssertionFailures(&resp.Diagnostics, state.CandidateModelId.ValueString(), failures)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *AuthorizationModelRolloutResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state AuthorizationModelRolloutModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.ReadAuthorizationModel(ctx, state.GetStoreId(), *NewAuthorizationModelModel(state.GetActiveModelId()))
+	if err != nil {
+		if internalError.IsStatusNotFound(err) {
+			resp.Diagnostics.AddWarning(
+				"Authorization model not found",
+				fmt.Sprintf("Active authorization model %q no longer exists; removing rollout from state.", state.GetActiveModelId()),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read authorization model, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *AuthorizationModelRolloutResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state AuthorizationModelRolloutModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modelJsonEqual, diags := plan.ModelJson.StringSemanticEquals(ctx, state.ModelJson)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the assertions changed, so they are verified against the active model
+	if modelJsonEqual {
+		failures, err := RunAssertions(ctx, r.queryClient, state.GetStoreId(), state.GetActiveModelId(), plan.Assertions)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to run assertions, got error: %s", err))
+			return
+		}
+
+		if len(failures) > 0 {
+			for _, failure := range failures {
+				resp.Diagnostics.AddError("Assertion Failed", fmt.Sprintf("Active authorization model %q does not pass the assertions: %s", state.GetActiveModelId(), failure))
+			}
+			return
+		}
+
+		plan.CandidateModelId = state.CandidateModelId
+		plan.ActiveModelId = state.ActiveModelId
+		plan.PreviousModelId = state.PreviousModelId
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
+	newState, failures, err := r.rollout(ctx, plan, &state)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to roll out authorization model, got error: %s", err))
+		return
+	}
+
+	if len(failures) > 0 {
+		addAssertionFailures(&resp.Diagnostics, newState.CandidateModelId.ValueString(), failures)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *AuthorizationModelRolloutResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Deletion is not possible, we treat it as a noop
+}
+
+func (r *AuthorizationModelRolloutResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError("Input Error", fmt.Sprintf("Input ID has to be in the format of <store_id>/<authorization_model_id>, but received: %s", req.ID))
+		return
+	}
+
+	authorizationModelModel, err := r.client.ReadAuthorizationModel(ctx, parts[0], *NewAuthorizationModelModel(parts[1]))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read authorization model, got error: %s", err))
+		return
+	}
+
+	state := AuthorizationModelRolloutModel{
+		StoreId:          types.StringValue(parts[0]),
+		ModelJson:        authorizationModelModel.ModelJson,
+		Assertions:       []AssertionModel{},
+		CandidateModelId: types.StringValue(parts[1]),
+		ActiveModelId:    types.StringValue(parts[1]),
+		PreviousModelId:  types.StringNull(),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,199 @@
+package authorizationmodel_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/openfga/terraform-provider-openfga/internal/provider/acceptance"
+)
+
+func TestAccAuthorizationModelRolloutResource(t *testing.T) {
+	activeModelIdSame := statecheck.CompareValue(compare.ValuesSame())
+	activeModelIdDiffers := statecheck.CompareValue(compare.ValuesDiffer())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccAuthorizationModelRolloutResourceConfig("[user]"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"openfga_authorization_model_rollout.test",
+						tfjsonpath.New("active_model_id"),
+						knownvalue.NotNull(),
+					),
+					statecheck.CompareValuePairs(
+						"openfga_authorization_model_rollout.test",
+						tfjsonpath.New("active_model_id"),
+						"openfga_authorization_model_rollout.test",
+						tfjsonpath.New("candidate_model_id"),
+						compare.ValuesSame(),
+					),
+					statecheck.ExpectKnownValue(
+						"openfga_authorization_model_rollout.test",
+						tfjsonpath.New("previous_model_id"),
+						knownvalue.Null(),
+					),
+					activeModelIdSame.AddStateValue(
+						"openfga_authorization_model_rollout.test",
+						tfjsonpath.New("active_model_id"),
+					),
+					activeModelIdDiffers.AddStateValue(
+						"openfga_authorization_model_rollout.test",
+						tfjsonpath.New("active_model_id"),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "openfga_authorization_model_rollout.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "active_model_id",
+				ImportStateVerifyIgnore:              []string{"assertions"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rollout, ok := s.RootModule().Resources["openfga_authorization_model_rollout.test"]
+					if !ok {
+						return "", fmt.Errorf("Unable to find resource openfga_authorization_model_rollout.test")
+					}
+
+					return fmt.Sprintf(
+						"%s/%s",
+						rollout.Primary.Attributes["store_id"],
+						rollout.Primary.Attributes["active_model_id"],
+					), nil
+				},
+			},
+			// Failing assertions testing
+			{
+				Config:      testAccAuthorizationModelRolloutResourceConfig("[user] and editor"),
+				ExpectError: regexp.MustCompile(`(?s)Assertion Failed.*is now the\s+latest\s+authorization\s+model`),
+			},
+			// Unchanged active model testing
+			{
+				Config: testAccAuthorizationModelRolloutResourceConfig("[user]"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					activeModelIdSame.AddStateValue(
+						"openfga_authorization_model_rollout.test",
+						tfjsonpath.New("active_model_id"),
+					),
+				},
+			},
+			// Update and Read testing
+			{
+				Config: testAccAuthorizationModelRolloutResourceConfig("[user] or editor"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							"openfga_authorization_model_rollout.test",
+							plancheck.ResourceActionUpdate,
+						),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					activeModelIdDiffers.AddStateValue(
+						"openfga_authorization_model_rollout.test",
+						tfjsonpath.New("active_model_id"),
+					),
+					statecheck.ExpectKnownValue(
+						"openfga_authorization_model_rollout.test",
+						tfjsonpath.New("previous_model_id"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccAuthorizationModelRolloutResourceConfig(viewer string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "openfga_store" "test" {
+	name = "test"
+}
+
+data "openfga_authorization_model_document" "base" {
+	dsl = <<EOT
+model
+	schema 1.1
+
+type user
+
+type document
+	relations
+		define editor: [user]
+		define viewer: [user]
+	EOT
+}
+
+resource "openfga_authorization_model" "base" {
+	store_id = openfga_store.test.id
+
+	model_json = data.openfga_authorization_model_document.base.result
+}
+
+resource "openfga_relationship_tuple" "test" {
+	store_id               = openfga_store.test.id
+	authorization_model_id = openfga_authorization_model.base.id
+
+	user     = "user:user-1"
+	relation = "viewer"
+	object   = "document:document-1"
+}
+
+data "openfga_authorization_model_document" "test" {
+	dsl = <<EOT
+model
+	schema 1.1
+
+type user
+
+type document
+	relations
+		define editor: [user]
+		define viewer: %[2]s
+	EOT
+}
+
+resource "openfga_authorization_model_rollout" "test" {
+	store_id   = openfga_store.test.id
+	model_json = data.openfga_authorization_model_document.test.result
+
+	assertions = [
+		{
+			user        = "user:user-1"
+			relation    = "viewer"
+			object      = "document:document-1"
+			expectation = true
+		},
+		{
+			user        = "user:user-2"
+			relation    = "viewer"
+			object      = "document:document-1"
+			expectation = false
+		},
+	]
+
+	depends_on = [openfga_relationship_tuple.test]
+}
+`, acceptance.ProviderConfig, viewer)
+}
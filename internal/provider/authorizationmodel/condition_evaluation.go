@@ -0,0 +1,112 @@
+package authorizationmodel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+	"github.com/openfga/terraform-provider-openfga/internal/condition"
+)
+
+type ConditionEvaluationResultModel struct {
+	Result            types.Bool   `tfsdk:"result"`
+	MissingParameters types.List   `tfsdk:"missing_parameters"`
+	Error             types.String `tfsdk:"error"`
+}
+
+var ConditionEvaluationResultAttributeTypes = map[string]attr.Type{
+	"result":             types.BoolType,
+	"missing_parameters": types.ListType{ElemType: types.StringType},
+	"error":              types.StringType,
+}
+
+func (customCondition CustomCondition) ToConditionProto() (*openfgav1.Condition, error) {
+	jsonBytes, err := json.Marshal(customCondition)
+	if err != nil {
+		return nil, fmt.Errorf("unable to transform custom condition into JSON, got error: %s", err)
+	}
+
+	var conditionProto openfgav1.Condition
+	err = protojson.Unmarshal(jsonBytes, &conditionProto)
+	if err != nil {
+		return nil, fmt.Errorf("unable to transform JSON into condition proto, got error: %s", err)
+	}
+
+	return &conditionProto, nil
+}
+
+func findConditionProto(modelJson string, conditionName string) (*openfgav1.Condition, error) {
+	modelProto, err := parseJsonToAuthorizationModelProto(modelJson)
+	if err != nil {
+		return nil, err
+	}
+
+	conditionProto, ok := modelProto.GetConditions()[conditionName]
+	if !ok {
+		return nil, fmt.Errorf("condition %q was not found in authorization model", conditionName)
+	}
+
+	return conditionProto, nil
+}
+
+func parseContextJson(contextJson string) (map[string]interface{}, error) {
+	if contextJson == "" {
+		return nil, nil
+	}
+
+	var context map[string]interface{}
+	err := json.Unmarshal([]byte(contextJson), &context)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse context JSON, got error: %s", err)
+	}
+
+	return context, nil
+}
+
+// EvaluateCondition evaluates a condition locally. Compilation and evaluation
+// failures are part of the result, while malformed input is returned as error.
+func EvaluateCondition(ctx context.Context, conditionProto *openfgav1.Condition, tupleContextJson string, contextJson string) (*ConditionEvaluationResultModel, error) {
+	tupleContext, err := parseContextJson(tupleContextJson)
+	if err != nil {
+		return nil, err
+	}
+
+	requestContext, err := parseContextJson(contextJson)
+	if err != nil {
+		return nil, err
+	}
+
+	evaluationResult, err := evaluateConditionProto(ctx, conditionProto, tupleContext, requestContext)
+	if err != nil {
+		return &ConditionEvaluationResultModel{
+			Result:            types.BoolValue(false),
+			MissingParameters: types.ListValueMust(types.StringType, []attr.Value{}),
+			Error:             types.StringValue(err.Error()),
+		}, nil
+	}
+
+	missingParameters := []attr.Value{}
+	for _, missingParameter := range evaluationResult.MissingParameters {
+		missingParameters = append(missingParameters, types.StringValue(missingParameter))
+	}
+
+	return &ConditionEvaluationResultModel{
+		Result:            types.BoolValue(evaluationResult.ConditionMet),
+		MissingParameters: types.ListValueMust(types.StringType, missingParameters),
+		Error:             types.StringNull(),
+	}, nil
+}
+
+func evaluateConditionProto(ctx context.Context, conditionProto *openfgav1.Condition, tupleContext map[string]interface{}, requestContext map[string]interface{}) (*condition.EvaluationResult, error) {
+	evaluableCondition, err := condition.NewEvaluableCondition(conditionProto)
+	if err != nil {
+		return nil, err
+	}
+
+	return evaluableCondition.Evaluate(ctx, tupleContext, requestContext)
+}
@@ -0,0 +1,145 @@
+package authorizationmodel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ConditionEvaluationDataSource{}
+var _ datasource.DataSourceWithConfigure = &ConditionEvaluationDataSource{}
+
+func NewConditionEvaluationDataSource() datasource.DataSource {
+	return &ConditionEvaluationDataSource{}
+}
+
+type ConditionEvaluationDataSource struct{}
+
+type ConditionEvaluationDataSourceModel struct {
+	ModelJson        jsontypes.Normalized `tfsdk:"model_json"`
+	ConditionName    types.String         `tfsdk:"condition_name"`
+	Condition        *CustomCondition     `tfsdk:"condition"`
+	TupleContextJson jsontypes.Normalized `tfsdk:"tuple_context_json"`
+	ContextJson      jsontypes.Normalized `tfsdk:"context_json"`
+
+	ConditionEvaluationResultModel
+}
+
+func (d *ConditionEvaluationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_condition_evaluation"
+}
+
+func (d *ConditionEvaluationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Evaluates a condition of an authorization model locally, without sending any request to the OpenFGA server.
+
+~> Like every data source, this data source requires a configured provider, including ` + "`api_url`" + `. To evaluate conditions without any provider configuration, use the ` + "`provider::openfga::evaluate_condition`" + ` function instead.
+
+The condition is evaluated with the same semantics as OpenFGA: the tuple context and the request context are merged, where values of the tuple context take precedence. If parameters are missing, the condition is not met.
+
+This allows to test conditions (e.g. grant windows or IP allowlists) as part of ` + "`terraform test`" + `.
+`,
+
+		Attributes: map[string]schema.Attribute{
+			"model_json": schema.StringAttribute{
+				MarkdownDescription: "The authorization model definition in JSON format containing the condition. Requires `condition_name` and conflicts with `condition`.",
+				CustomType:          jsontypes.NormalizedType{},
+				Optional:            true,
+			},
+			"condition_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the condition within `model_json` to evaluate.",
+				Optional:            true,
+			},
+			"condition": schema.SingleNestedAttribute{
+				MarkdownDescription: "A condition as Terraform object. Conflicts with `model_json` and `condition_name`.",
+				Optional:            true,
+				Attributes:          CustomConditionSchema(),
+			},
+			"tuple_context_json": schema.StringAttribute{
+				MarkdownDescription: "The (partial) context of the relationship tuple the condition is attached to.",
+				CustomType:          jsontypes.NormalizedType{},
+				Optional:            true,
+			},
+			"context_json": schema.StringAttribute{
+				MarkdownDescription: "The (partial) context provided with the request.",
+				CustomType:          jsontypes.NormalizedType{},
+				Optional:            true,
+			},
+			"result": schema.BoolAttribute{
+				MarkdownDescription: "Boolean value indicating whether the condition is met.",
+				Computed:            true,
+			},
+			"missing_parameters": schema.ListAttribute{
+				MarkdownDescription: "The parameters of the condition that were not provided by any context.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"error": schema.StringAttribute{
+				MarkdownDescription: "The error that occurred while compiling or evaluating the condition, if any.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d ConditionEvaluationDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("model_json"),
+			path.MatchRoot("condition"),
+		),
+		datasourcevalidator.RequiredTogether(
+			path.MatchRoot("model_json"),
+			path.MatchRoot("condition_name"),
+		),
+	}
+}
+
+func (d *ConditionEvaluationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+}
+
+func (d *ConditionEvaluationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ConditionEvaluationDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var conditionProto *openfgav1.Condition
+	var err error
+	if state.Condition != nil {
+		conditionProto, err = state.Condition.ToConditionProto()
+	} else {
+		conditionProto, err = findConditionProto(state.ModelJson.ValueString(), state.ConditionName.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Input Error", fmt.Sprintf("Unable to extract condition, got error: %s", err))
+		return
+	}
+
+	result, err := EvaluateCondition(ctx, conditionProto, state.TupleContextJson.ValueString(), state.ContextJson.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Input Error", fmt.Sprintf("Unable to evaluate condition, got error: %s", err))
+		return
+	}
+
+	state.ConditionEvaluationResultModel = *result
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
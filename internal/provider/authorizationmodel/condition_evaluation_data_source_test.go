@@ -0,0 +1,146 @@
+package authorizationmodel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/openfga/terraform-provider-openfga/internal/provider/acceptance"
+)
+
+func TestAccConditionEvaluationDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccConditionEvaluationDataSourceConfig(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.openfga_condition_evaluation.met",
+						tfjsonpath.New("result"),
+						knownvalue.Bool(true),
+					),
+					statecheck.ExpectKnownValue(
+						"data.openfga_condition_evaluation.not_met",
+						tfjsonpath.New("result"),
+						knownvalue.Bool(false),
+					),
+					statecheck.ExpectKnownValue(
+						"data.openfga_condition_evaluation.missing_parameters",
+						tfjsonpath.New("missing_parameters"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.StringExact("user_ip"),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"data.openfga_condition_evaluation.invalid_parameter",
+						tfjsonpath.New("error"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"data.openfga_condition_evaluation.custom_condition",
+						tfjsonpath.New("result"),
+						knownvalue.Bool(true),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccConditionEvaluationDataSourceConfig() string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "openfga_authorization_model_document" "test" {
+	dsl = <<EOT
+model
+	schema 1.1
+
+type user
+
+type document
+	relations
+		define viewer: [user with in_network]
+
+condition in_network(user_ip: ipaddress, cidr: string) {
+	user_ip.in_cidr(cidr)
+}
+	EOT
+}
+
+data "openfga_condition_evaluation" "met" {
+	model_json     = data.openfga_authorization_model_document.test.result
+	condition_name = "in_network"
+
+	tuple_context_json = jsonencode({
+		cidr = "192.168.0.0/24"
+	})
+
+	context_json = jsonencode({
+		user_ip = "192.168.0.1"
+	})
+}
+
+data "openfga_condition_evaluation" "not_met" {
+	model_json     = data.openfga_authorization_model_document.test.result
+	condition_name = "in_network"
+
+	tuple_context_json = jsonencode({
+		cidr = "192.168.0.0/24"
+	})
+
+	context_json = jsonencode({
+		user_ip = "10.0.0.1"
+	})
+}
+
+data "openfga_condition_evaluation" "missing_parameters" {
+	model_json     = data.openfga_authorization_model_document.test.result
+	condition_name = "in_network"
+
+	tuple_context_json = jsonencode({
+		cidr = "192.168.0.0/24"
+	})
+}
+
+data "openfga_condition_evaluation" "invalid_parameter" {
+	model_json     = data.openfga_authorization_model_document.test.result
+	condition_name = "in_network"
+
+	context_json = jsonencode({
+		user_ip = "not an ip"
+		cidr    = "192.168.0.0/24"
+	})
+}
+
+data "openfga_condition_evaluation" "custom_condition" {
+	condition = {
+		name       = "larger_than"
+		expression = "provided > required"
+		parameters = {
+			provided = {
+				type_name = "TYPE_NAME_INT"
+			}
+			required = {
+				type_name = "TYPE_NAME_INT"
+			}
+		}
+	}
+
+	tuple_context_json = jsonencode({
+		required = 50
+	})
+
+	context_json = jsonencode({
+		provided = 100
+	})
+}
+`, acceptance.ProviderConfig)
+}
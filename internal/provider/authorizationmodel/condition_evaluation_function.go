@@ -0,0 +1,89 @@
+package authorizationmodel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &ConditionEvaluationFunction{}
+
+func NewConditionEvaluationFunction() function.Function {
+	return &ConditionEvaluationFunction{}
+}
+
+type ConditionEvaluationFunction struct{}
+
+func (f *ConditionEvaluationFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "evaluate_condition"
+}
+
+func (f *ConditionEvaluationFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Evaluates a condition of an authorization model locally",
+		MarkdownDescription: `
+Evaluates a condition of an authorization model locally, without contacting an OpenFGA server. This is the function equivalent of the ` + "`openfga_condition_evaluation`" + ` data source.
+
+Returns an object with the attributes ` + "`result`" + ` (whether the condition is met), ` + "`missing_parameters`" + ` (the parameters that were not provided by any context) and ` + "`error`" + ` (the error that occurred while compiling or evaluating the condition, if any).
+`,
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "model_json",
+				MarkdownDescription: "The authorization model definition in JSON format containing the condition.",
+			},
+			function.StringParameter{
+				Name:                "condition_name",
+				MarkdownDescription: "The name of the condition to evaluate.",
+			},
+			function.StringParameter{
+				Name:                "tuple_context_json",
+				MarkdownDescription: "The (partial) context of the relationship tuple the condition is attached to.",
+				AllowNullValue:      true,
+			},
+			function.StringParameter{
+				Name:                "context_json",
+				MarkdownDescription: "The (partial) context provided with the request.",
+				AllowNullValue:      true,
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: ConditionEvaluationResultAttributeTypes,
+		},
+	}
+}
+
+func (f *ConditionEvaluationFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var modelJson, conditionName string
+	var tupleContextJson, contextJson *string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &modelJson, &conditionName, &tupleContextJson, &contextJson))
+
+	if resp.Error != nil {
+		return
+	}
+
+	conditionProto, err := findConditionProto(modelJson, conditionName)
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("Unable to extract condition, got error: %s", err))
+		return
+	}
+
+	result, err := EvaluateCondition(ctx, conditionProto, valueOrEmpty(tupleContextJson), valueOrEmpty(contextJson))
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("Unable to evaluate condition, got error: %s", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+func valueOrEmpty(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
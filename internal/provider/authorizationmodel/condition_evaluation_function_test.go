@@ -0,0 +1,89 @@
+package authorizationmodel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/openfga/terraform-provider-openfga/internal/provider/acceptance"
+)
+
+func TestAccConditionEvaluationFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConditionEvaluationFunctionConfig(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"met",
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"result":             knownvalue.Bool(true),
+							"missing_parameters": knownvalue.ListExact([]knownvalue.Check{}),
+							"error":              knownvalue.Null(),
+						}),
+					),
+					statecheck.ExpectKnownOutputValue(
+						"missing_parameters",
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"result": knownvalue.Bool(false),
+							"missing_parameters": knownvalue.ListExact([]knownvalue.Check{
+								knownvalue.StringExact("provided"),
+							}),
+							"error": knownvalue.Null(),
+						}),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccConditionEvaluationFunctionConfig() string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "openfga_authorization_model_document" "test" {
+	dsl = <<EOT
+model
+	schema 1.1
+
+type user
+
+type document
+	relations
+		define viewer: [user with larger_than]
+
+condition larger_than(required: int, provided: int) {
+	provided > required
+}
+	EOT
+}
+
+output "met" {
+	value = provider::openfga::evaluate_condition(
+		data.openfga_authorization_model_document.test.result,
+		"larger_than",
+		jsonencode({ required = 50 }),
+		jsonencode({ provided = 100 }),
+	)
+}
+
+output "missing_parameters" {
+	value = provider::openfga::evaluate_condition(
+		data.openfga_authorization_model_document.test.result,
+		"larger_than",
+		jsonencode({ required = 50 }),
+		null,
+	)
+}
+`, acceptance.ProviderConfig)
+}
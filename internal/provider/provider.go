@@ -2,23 +2,50 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 	"os"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
+	openfga "github.com/openfga/go-sdk"
 	"github.com/openfga/go-sdk/client"
 	"github.com/openfga/go-sdk/credentials"
 
+	"github.com/openfga/terraform-provider-openfga/internal/changefeed"
+	"github.com/openfga/terraform-provider-openfga/internal/provider/access"
 	"github.com/openfga/terraform-provider-openfga/internal/provider/authorizationmodel"
+	"github.com/openfga/terraform-provider-openfga/internal/provider/providerdata"
 	"github.com/openfga/terraform-provider-openfga/internal/provider/query"
 	"github.com/openfga/terraform-provider-openfga/internal/provider/relationshiptuple"
 	"github.com/openfga/terraform-provider-openfga/internal/provider/store"
+	"github.com/openfga/terraform-provider-openfga/internal/provider/storefile"
+	"github.com/openfga/terraform-provider-openfga/internal/querycache"
+	"github.com/openfga/terraform-provider-openfga/internal/ratelimit"
+	"github.com/openfga/terraform-provider-openfga/internal/writebatcher"
+)
+
+const (
+	defaultQueryCacheTtl        = time.Minute
+	defaultQueryCacheMaxEntries = 1000
+
+	defaultWriteBatchingWindow  = 50 * time.Millisecond
+	defaultWriteBatchingMaxSize = 100
+
+	// tupleSnapshotTtl covers the refresh of all relationship tuples of a run,
+	// which happens at once, while a long-lived provider process does not
+	// refresh from an outdated snapshot.
+	tupleSnapshotTtl = time.Minute
 )
 
 // Ensure OpenFgaProvider satisfies various provider interfaces.
@@ -39,6 +66,100 @@ type OpenFgaProviderModel struct {
 	ApiScopes      types.String `tfsdk:"api_scopes"`
 	ApiAudience    types.String `tfsdk:"api_audience"`
 	ApiTokenIssuer types.String `tfsdk:"api_token_issuer"`
+
+	Consistency types.String `tfsdk:"consistency"`
+
+	QueryCache         *QueryCacheModel `tfsdk:"query_cache"`
+	IncrementalRefresh types.Bool       `tfsdk:"incremental_refresh"`
+	SnapshotRefresh    types.Bool       `tfsdk:"snapshot_refresh"`
+
+	WriteBatching *WriteBatchingModel `tfsdk:"write_batching"`
+
+	RequestLimitsModel
+	ReadLimits  *RequestLimitsModel `tfsdk:"read_limits"`
+	WriteLimits *RequestLimitsModel `tfsdk:"write_limits"`
+	QueryLimits *RequestLimitsModel `tfsdk:"query_limits"`
+}
+
+// QueryCacheModel describes the query cache settings of the provider.
+type QueryCacheModel struct {
+	Ttl        types.String `tfsdk:"ttl"`
+	MaxEntries types.Int64  `tfsdk:"max_entries"`
+}
+
+func (model QueryCacheModel) GetTtl() (time.Duration, error) {
+	if model.Ttl.IsNull() {
+		return defaultQueryCacheTtl, nil
+	}
+
+	return time.ParseDuration(model.Ttl.ValueString())
+}
+
+func (model QueryCacheModel) GetMaxEntries() int {
+	if model.MaxEntries.IsNull() {
+		return defaultQueryCacheMaxEntries
+	}
+
+	return int(model.MaxEntries.ValueInt64())
+}
+
+// WriteBatchingModel describes the write batching settings of the provider.
+type WriteBatchingModel struct {
+	Window  types.String `tfsdk:"window"`
+	MaxSize types.Int64  `tfsdk:"max_size"`
+}
+
+func (model WriteBatchingModel) GetWindow() (time.Duration, error) {
+	if model.Window.IsNull() {
+		return defaultWriteBatchingWindow, nil
+	}
+
+	return time.ParseDuration(model.Window.ValueString())
+}
+
+func (model WriteBatchingModel) GetMaxSize() int {
+	if model.MaxSize.IsNull() {
+		return defaultWriteBatchingMaxSize
+	}
+
+	return int(model.MaxSize.ValueInt64())
+}
+
+// RequestLimitsModel describes limits on the requests sent to the OpenFGA
+// server.
+type RequestLimitsModel struct {
+	MaxConcurrentRequests types.Int64 `tfsdk:"max_concurrent_requests"`
+	RequestsPerSecond     types.Int64 `tfsdk:"requests_per_second"`
+}
+
+func (model *RequestLimitsModel) NewLimiter(name string) *ratelimit.Limiter {
+	if model == nil {
+		return nil
+	}
+
+	return ratelimit.New(name, ratelimit.Limits{
+		MaxConcurrentRequests: int(model.MaxConcurrentRequests.ValueInt64()),
+		RequestsPerSecond:     int(model.RequestsPerSecond.ValueInt64()),
+	})
+}
+
+func requestLimitsAttributes(requests string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"max_concurrent_requests": schema.Int64Attribute{
+			MarkdownDescription: fmt.Sprintf("The maximum number of %s sent to the OpenFGA server concurrently. Further requests wait until a request finished. If not set, the number of concurrent requests is not limited.", requests),
+			Optional:            true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"requests_per_second": schema.Int64Attribute{
+			MarkdownDescription: fmt.Sprintf("The maximum number of %s started per second. Further requests are delayed. If not set, the rate of requests is not limited.", requests),
+			Optional:            true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+	}
 }
 
 func (p *OpenFgaProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -84,8 +205,77 @@ It can be used to create resources (like Stores, Authorization Models, Relations
 				MarkdownDescription: "The issuer URL or full token endpoint URL for client credentials authentication. If only the issuer URL is provided, the `oauth/token` path is used to retrieve an access token. This can also be sourced from the `FGA_API_TOKEN_ISSUER` environment variable.",
 				Optional:            true,
 			},
+			"consistency": schema.StringAttribute{
+				MarkdownDescription: "The default consistency preference of queries and relationship tuple reads, which can be overridden per data source. Must be one of `MINIMIZE_LATENCY` or `HIGHER_CONSISTENCY`. If not set, the default of the OpenFGA server is used.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(relationshiptuple.ConsistencyValues...),
+				},
+			},
+			"incremental_refresh": schema.BoolAttribute{
+				MarkdownDescription: "Whether relationship tuples are refreshed from the changes of their store since their last refresh, instead of reading every relationship tuple individually. The position in the changes is kept in the private state of each relationship tuple, and the changes of a store are read once per run. Relationship tuples without a valid position, e.g. as it expired, are read individually. Defaults to `false`.",
+				Optional:            true,
+			},
+			"snapshot_refresh": schema.BoolAttribute{
+				MarkdownDescription: "Whether relationship tuples are refreshed from a snapshot of all relationship tuples of their store, which is read on the first refresh and reused for a minute, i.e. once per run. This reduces the refresh of many relationship tuples of a store to a few large requests, but reads all relationship tuples of the store, including those not managed by Terraform. Takes precedence over `incremental_refresh`. Defaults to `false`.",
+				Optional:            true,
+			},
+			"query_cache": schema.SingleNestedAttribute{
+				MarkdownDescription: "Enables an in-memory cache of the results of check, list objects, list users and batch check queries, which is shared by all data sources of this provider instance. Results are cached per store, authorization model, request and consistency preference, and identical queries running concurrently are sent to the server only once. Failed queries are not cached.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"ttl": schema.StringAttribute{
+						MarkdownDescription: "The duration for which results are cached, e.g. `30s` or `5m`. Defaults to `1m`.",
+						Optional:            true,
+					},
+					"max_entries": schema.Int64Attribute{
+						MarkdownDescription: "The maximum number of cached results. Once reached, the least recently used results are evicted. Defaults to `1000`.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+				},
+			},
+			"read_limits": schema.SingleNestedAttribute{
+				MarkdownDescription: "Limits on read requests, e.g. reading stores, authorization models, relationship tuples and changes, in addition to the limits on all requests.",
+				Optional:            true,
+				Attributes:          requestLimitsAttributes("read requests"),
+			},
+			"write_limits": schema.SingleNestedAttribute{
+				MarkdownDescription: "Limits on write requests, e.g. creating stores, writing authorization models and writing or deleting relationship tuples, in addition to the limits on all requests.",
+				Optional:            true,
+				Attributes:          requestLimitsAttributes("write requests"),
+			},
+			"query_limits": schema.SingleNestedAttribute{
+				MarkdownDescription: "Limits on query requests, i.e. check, batch check, expand, list objects and list users, in addition to the limits on all requests.",
+				Optional:            true,
+				Attributes:          requestLimitsAttributes("query requests"),
+			},
+			"write_batching": schema.SingleNestedAttribute{
+				MarkdownDescription: "Enables the coalescing of the writes and deletes of individual `openfga_relationship_tuple` resources into batches, which are sent as a single Write request per store and authorization model. This speeds up configurations with many relationship tuples, as Terraform creates and destroys them in parallel. If a batch fails, its relationship tuples are written individually, so that every resource receives its own result.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"window": schema.StringAttribute{
+						MarkdownDescription: "The duration for which writes and deletes are collected before a batch is sent, e.g. `20ms` or `100ms`. Defaults to `50ms`.",
+						Optional:            true,
+					},
+					"max_size": schema.Int64Attribute{
+						MarkdownDescription: "The maximum number of relationship tuples per batch. Full batches are sent immediately. Defaults to `100`, the maximum of the OpenFGA server.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.Between(1, 100),
+						},
+					},
+				},
+			},
 		},
 	}
+
+	// The limits on all requests are top-level attributes of the provider.
+	for name, attribute := range requestLimitsAttributes("requests") {
+		resp.Schema.Attributes[name] = attribute
+	}
 }
 
 func (p *OpenFgaProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
@@ -250,9 +440,27 @@ func (p *OpenFgaProvider) Configure(ctx context.Context, req provider.ConfigureR
 		}
 	}
 
+	var httpClient *http.Client
+	requestLimiter := config.RequestLimitsModel.NewLimiter("all")
+	classLimiters := map[ratelimit.Class]*ratelimit.Limiter{
+		ratelimit.ClassRead:  config.ReadLimits.NewLimiter("read"),
+		ratelimit.ClassWrite: config.WriteLimits.NewLimiter("write"),
+		ratelimit.ClassQuery: config.QueryLimits.NewLimiter("query"),
+	}
+	limited := requestLimiter != nil
+	for _, limiter := range classLimiters {
+		limited = limited || limiter != nil
+	}
+	if limited {
+		httpClient = &http.Client{
+			Transport: ratelimit.NewTransport(nil, requestLimiter, classLimiters),
+		}
+	}
+
 	client, err := client.NewSdkClient(&client.ClientConfiguration{
 		ApiUrl:      apiUrl,
 		Credentials: &apiCredentials,
+		HTTPClient:  httpClient,
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -264,14 +472,59 @@ func (p *OpenFgaProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	var queryCache *querycache.Cache
+	if config.QueryCache != nil {
+		ttl, err := config.QueryCache.GetTtl()
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("query_cache").AtName("ttl"),
+				"Invalid Query Cache TTL",
+				fmt.Sprintf("Unable to parse the query cache TTL, got error: %s", err),
+			)
+			return
+		}
+
+		queryCache = querycache.New(ttl, config.QueryCache.GetMaxEntries())
+	}
+
+	var changeFeed *changefeed.Feed
+	if config.IncrementalRefresh.ValueBool() {
+		changeFeed = changefeed.New(client)
+	}
+
+	var tupleSnapshots *querycache.Cache
+	if config.SnapshotRefresh.ValueBool() {
+		tupleSnapshots = querycache.New(tupleSnapshotTtl, 0)
+	}
+
+	var writeBatcher *writebatcher.Batcher
+	if config.WriteBatching != nil {
+		window, err := config.WriteBatching.GetWindow()
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("write_batching").AtName("window"),
+				"Invalid Write Batching Window",
+				fmt.Sprintf("Unable to parse the write batching window, got error: %s", err),
+			)
+			return
+		}
+
+		writeBatcher = writebatcher.New(client, window, config.WriteBatching.GetMaxSize())
+	}
+
+	providerData := providerdata.NewProviderData(client, openfga.ConsistencyPreference(config.Consistency.ValueString()), queryCache, changeFeed, tupleSnapshots, writeBatcher)
+
+	resp.DataSourceData = providerData
+	resp.ResourceData = providerData
 }
 
 func (p *OpenFgaProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		store.NewStoreResource,
 		authorizationmodel.NewAuthorizationModelResource,
+		authorizationmodel.NewAuthorizationModelAssertionsResource,
+		authorizationmodel.NewAuthorizationModelRolloutResource,
+		storefile.NewStoreFileDeploymentResource,
 		relationshiptuple.NewRelationshipTupleResource,
 	}
 }
@@ -283,16 +536,29 @@ func (p *OpenFgaProvider) DataSources(ctx context.Context) []func() datasource.D
 		authorizationmodel.NewAuthorizationModelDocumentDataSource,
 		authorizationmodel.NewAuthorizationModelDataSource,
 		authorizationmodel.NewAuthorizationModelsDataSource,
+		authorizationmodel.NewConditionEvaluationDataSource,
+		storefile.NewModelTestDataSource,
+		storefile.NewStoreFileDataSource,
 		relationshiptuple.NewRelationshipTupleDataSource,
 		relationshiptuple.NewRelationshipTuplesDataSource,
+		relationshiptuple.NewRelationshipTupleChangesDataSource,
+		relationshiptuple.NewTupleFileDataSource,
 		query.NewCheckQueryDataSource,
+		query.NewCheckExplainDataSource,
+		query.NewBatchCheckQueryDataSource,
 		query.NewListObjectsQueryDataSource,
+		query.NewExpandQueryDataSource,
 		query.NewListUsersQueryDataSource,
+		query.NewModelRegressionDataSource,
+		access.NewAccessMatrixDataSource,
+		access.NewUserEntitlementsDataSource,
 	}
 }
 
 func (p *OpenFgaProvider) Functions(ctx context.Context) []func() function.Function {
-	return []func() function.Function{}
+	return []func() function.Function{
+		authorizationmodel.NewConditionEvaluationFunction,
+	}
 }
 
 func New(version string) func() provider.Provider {
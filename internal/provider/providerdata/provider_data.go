@@ -0,0 +1,48 @@
+package providerdata
+
+import (
+	openfga "github.com/openfga/go-sdk"
+	"github.com/openfga/go-sdk/client"
+
+	"github.com/openfga/terraform-provider-openfga/internal/changefeed"
+	"github.com/openfga/terraform-provider-openfga/internal/querycache"
+	"github.com/openfga/terraform-provider-openfga/internal/writebatcher"
+)
+
+// ProviderData is passed by the provider to all resources and data sources.
+type ProviderData struct {
+	Client *client.OpenFgaClient
+
+	// Consistency is the default consistency preference of queries and reads.
+	// It is empty if the server default should be used.
+	Consistency openfga.ConsistencyPreference
+
+	// QueryCache is shared by the query data sources of this provider
+	// instance. It is nil if query results should not be cached.
+	QueryCache *querycache.Cache
+
+	// ChangeFeed is used to refresh relationship tuples incrementally. It is
+	// nil if every relationship tuple should be read individually.
+	ChangeFeed *changefeed.Feed
+
+	// TupleSnapshots holds all relationship tuples of a store, keyed by store
+	// ID, to refresh relationship tuples from. It is nil if relationship tuples
+	// should not be refreshed from snapshots.
+	TupleSnapshots *querycache.Cache
+
+	// WriteBatcher coalesces the writes and deletes of individual relationship
+	// tuples. It is nil if every relationship tuple should be written on its
+	// own.
+	WriteBatcher *writebatcher.Batcher
+}
+
+func NewProviderData(client *client.OpenFgaClient, consistency openfga.ConsistencyPreference, queryCache *querycache.Cache, changeFeed *changefeed.Feed, tupleSnapshots *querycache.Cache, writeBatcher *writebatcher.Batcher) *ProviderData {
+	return &ProviderData{
+		Client:         client,
+		Consistency:    consistency,
+		QueryCache:     queryCache,
+		ChangeFeed:     changeFeed,
+		TupleSnapshots: tupleSnapshots,
+		WriteBatcher:   writeBatcher,
+	}
+}
@@ -0,0 +1,220 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/openfga/terraform-provider-openfga/internal/provider/providerdata"
+	"github.com/openfga/terraform-provider-openfga/internal/provider/relationshiptuple"
+)
+
+const (
+	defaultBatchCheckMaxBatchSize        = 50
+	defaultBatchCheckMaxParallelRequests = 10
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &BatchCheckQueryDataSource{}
+var _ datasource.DataSourceWithConfigure = &BatchCheckQueryDataSource{}
+
+func NewBatchCheckQueryDataSource() datasource.DataSource {
+	return &BatchCheckQueryDataSource{}
+}
+
+type BatchCheckQueryDataSource struct {
+	client *QueryClient
+}
+
+type BatchCheckQueryDataSourceModel struct {
+	StoreId              types.String `tfsdk:"store_id"`
+	AuthorizationModelId types.String `tfsdk:"authorization_model_id"`
+
+	Checks              map[string]CheckQueryModel `tfsdk:"checks"`
+	MaxBatchSize        types.Int64                `tfsdk:"max_batch_size"`
+	MaxParallelRequests types.Int64                `tfsdk:"max_parallel_requests"`
+	relationshiptuple.ConsistencyModel
+
+	Result map[string]BatchCheckResultModel `tfsdk:"result"`
+}
+
+func (model BatchCheckQueryDataSourceModel) GetMaxBatchSize() int32 {
+	if model.MaxBatchSize.IsNull() {
+		return defaultBatchCheckMaxBatchSize
+	}
+
+	return int32(model.MaxBatchSize.ValueInt64())
+}
+
+func (model BatchCheckQueryDataSourceModel) GetMaxParallelRequests() int32 {
+	if model.MaxParallelRequests.IsNull() {
+		return defaultBatchCheckMaxParallelRequests
+	}
+
+	return int32(model.MaxParallelRequests.ValueInt64())
+}
+
+func (d *BatchCheckQueryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_batch_check_query"
+}
+
+func (d *BatchCheckQueryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A 'batch check' query can be performed to establish for many checks at once whether a particular user has a specific relationship with a particular object. The checks are sent in chunks to the BatchCheck endpoint, instead of performing a separate request per check.",
+
+		Attributes: map[string]schema.Attribute{
+			"store_id": schema.StringAttribute{
+				MarkdownDescription: "The unique ID of the OpenFGA store this query is run against",
+				Required:            true,
+			},
+			"authorization_model_id": schema.StringAttribute{
+				MarkdownDescription: "The unique ID of the OpenFGA authorization model this query is run against",
+				Optional:            true,
+			},
+			"checks": schema.MapNestedAttribute{
+				MarkdownDescription: "The checks to perform, keyed by an arbitrary correlation key",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"user": schema.StringAttribute{
+							MarkdownDescription: "The user of the check",
+							Required:            true,
+						},
+						"relation": schema.StringAttribute{
+							MarkdownDescription: "The relation to check for",
+							Required:            true,
+						},
+						"object": schema.StringAttribute{
+							MarkdownDescription: "The object of the check",
+							Required:            true,
+						},
+						"contextual_tuples": schema.ListNestedAttribute{
+							MarkdownDescription: "The contextual tuples that should be considered for the check",
+							Optional:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"user": schema.StringAttribute{
+										MarkdownDescription: "The user of the contextual relationship tuple",
+										Required:            true,
+									},
+									"relation": schema.StringAttribute{
+										MarkdownDescription: "The relation of the contextual relationship tuple",
+										Required:            true,
+									},
+									"object": schema.StringAttribute{
+										MarkdownDescription: "The object of the contextual relationship tuple",
+										Required:            true,
+									},
+									"condition": schema.SingleNestedAttribute{
+										MarkdownDescription: "A condition of the contextual relationship tuple",
+										Optional:            true,
+										Attributes: map[string]schema.Attribute{
+											"name": schema.StringAttribute{
+												MarkdownDescription: "The name of the condition",
+												Required:            true,
+											},
+											"context_json": schema.StringAttribute{
+												MarkdownDescription: "The (partial) context under which the condition is evaluated",
+												CustomType:          jsontypes.NormalizedType{},
+												Optional:            true,
+											},
+										},
+									},
+								},
+							},
+						},
+						"context_json": schema.StringAttribute{
+							MarkdownDescription: "The (partial) context under which the condition is evaluated",
+							CustomType:          jsontypes.NormalizedType{},
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"max_batch_size": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of checks sent in a single BatchCheck request, between `1` and `50`, the default limit of the OpenFGA server. Defaults to `50`",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 50),
+				},
+			},
+			"max_parallel_requests": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of BatchCheck requests sent concurrently. Defaults to `10`",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"consistency": schema.StringAttribute{
+				MarkdownDescription: "The consistency preference of the query, either `MINIMIZE_LATENCY` or `HIGHER_CONSISTENCY`. Defaults to the consistency preference of the provider",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(relationshiptuple.ConsistencyValues...),
+				},
+			},
+			"result": schema.MapNestedAttribute{
+				MarkdownDescription: "The results of the checks, keyed by the correlation keys of `checks`",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"allowed": schema.BoolAttribute{
+							MarkdownDescription: "Boolean value indicating whether the relationship exists. Null if the check failed",
+							Computed:            true,
+						},
+						"error": schema.StringAttribute{
+							MarkdownDescription: "The error message if the check failed",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *BatchCheckQueryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providerdata.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = NewQueryClient(providerData.Client, providerData.Consistency, providerData.QueryCache)
+}
+
+func (d *BatchCheckQueryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state BatchCheckQueryDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.BatchCheck(ctx, state.StoreId.ValueString(), state.AuthorizationModelId.ValueString(), state.Checks, state.GetMaxBatchSize(), state.GetMaxParallelRequests(), state.Consistency.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to perform batch check query, got error: %s", err))
+		return
+	}
+
+	state.Result = result
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,24 @@
+package query
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type BatchCheckResultModel struct {
+	Allowed types.Bool   `tfsdk:"allowed"`
+	Error   types.String `tfsdk:"error"`
+}
+
+func NewBatchCheckResultModel(allowed bool) *BatchCheckResultModel {
+	return &BatchCheckResultModel{
+		Allowed: types.BoolValue(allowed),
+		Error:   types.StringNull(),
+	}
+}
+
+func NewBatchCheckResultModelFromError(message string) *BatchCheckResultModel {
+	return &BatchCheckResultModel{
+		Allowed: types.BoolNull(),
+		Error:   types.StringValue(message),
+	}
+}
@@ -0,0 +1,254 @@
+package query
+
+import (
+	"context"
+	"strings"
+
+	openfga "github.com/openfga/go-sdk"
+	"github.com/openfga/go-sdk/client"
+
+	"github.com/openfga/terraform-provider-openfga/internal/provider/relationshiptuple"
+)
+
+type CheckExplainClient struct {
+	queryClient             *QueryClient
+	relationshipTupleClient *relationshiptuple.RelationshipTupleClient
+}
+
+func NewCheckExplainClient(client *client.OpenFgaClient, consistency openfga.ConsistencyPreference) *CheckExplainClient {
+	return &CheckExplainClient{
+		queryClient:             NewQueryClient(client, consistency, nil),
+		relationshipTupleClient: relationshiptuple.NewRelationshipTupleClient(client, consistency),
+	}
+}
+
+// checkExplainer walks the userset trees returned by Expand, starting at the
+// queried object and relation, until it reaches the queried user.
+type checkExplainer struct {
+	queryClient          *QueryClient
+	storeId              string
+	authorizationModelId string
+	consistency          string
+	user                 string
+	maxDepth             int
+
+	trees    map[string]*openfga.UsersetTree
+	visiting map[string]bool
+
+	// truncated records whether a userset was not expanded because it
+	// exceeds the maximum depth.
+	truncated bool
+}
+
+// Explain returns the access paths through which the user of the query has
+// the relation to the object, ordered from the user to the object. Conditions
+// of relationship tuples are reported, but not evaluated. The returned flag
+// reports whether usersets beyond maxDepth were skipped, in which case the
+// paths may be incomplete.
+func (wrapper *CheckExplainClient) Explain(ctx context.Context, storeId string, authorizationModelId string, model relationshiptuple.RelationshipTupleModel, maxDepth int, consistency string) ([]CheckExplainPathModel, bool, error) {
+	explainer := checkExplainer{
+		queryClient:          wrapper.queryClient,
+		storeId:              storeId,
+		authorizationModelId: authorizationModelId,
+		consistency:          consistency,
+		user:                 model.GetUser(),
+		maxDepth:             maxDepth,
+		trees:                map[string]*openfga.UsersetTree{},
+		visiting:             map[string]bool{},
+	}
+
+	paths, err := explainer.explainUserset(ctx, model.GetObject()+"#"+model.GetRelation(), 0)
+	if err != nil {
+		return nil, false, err
+	}
+
+	conditions := map[string]*relationshiptuple.RelationshipConditionModel{}
+
+	pathModels := []CheckExplainPathModel{}
+	for _, steps := range paths {
+		for index, step := range steps {
+			if step.GetType() != CheckExplainStepTypeTuple {
+				continue
+			}
+
+			key := step.GetUser() + " " + step.GetUserset()
+
+			condition, ok := conditions[key]
+			if !ok {
+				tuple, err := wrapper.relationshipTupleClient.ReadRelationshipTuple(ctx, storeId, step.RelationshipTupleModel, consistency)
+				if err != nil {
+					return nil, false, err
+				}
+
+				condition = tuple.GetCondition()
+				conditions[key] = condition
+			}
+
+			steps[index].Condition = condition
+		}
+
+		pathModels = append(pathModels, *NewCheckExplainPathModel(model.GetUser(), steps))
+	}
+
+	return pathModels, explainer.truncated, nil
+}
+
+func (explainer *checkExplainer) expand(ctx context.Context, object string, relation string) (*openfga.UsersetTree, error) {
+	key := object + "#" + relation
+	if tree, ok := explainer.trees[key]; ok {
+		return tree, nil
+	}
+
+	tree, err := explainer.queryClient.ExpandTree(ctx, explainer.storeId, explainer.authorizationModelId, *NewExpandQueryModel(relation, object, nil), explainer.consistency)
+	if err != nil {
+		return nil, err
+	}
+
+	explainer.trees[key] = tree
+
+	return tree, nil
+}
+
+// explainUserset returns the paths from the user to the given userset. Usersets
+// which are already being explained further up are skipped to break cycles,
+// and usersets beyond the maximum depth mark the explanation as truncated.
+func (explainer *checkExplainer) explainUserset(ctx context.Context, userset string, depth int) ([][]CheckExplainStepModel, error) {
+	if explainer.visiting[userset] {
+		return nil, nil
+	}
+
+	if depth > explainer.maxDepth {
+		explainer.truncated = true
+		return nil, nil
+	}
+
+	object, relation, ok := splitUserset(userset)
+	if !ok {
+		return nil, nil
+	}
+
+	explainer.visiting[userset] = true
+	defer delete(explainer.visiting, userset)
+
+	tree, err := explainer.expand(ctx, object, relation)
+	if err != nil {
+		return nil, err
+	}
+
+	if tree.Root == nil {
+		return nil, nil
+	}
+
+	return explainer.explainNode(ctx, *tree.Root, depth)
+}
+
+func (explainer *checkExplainer) explainNode(ctx context.Context, node openfga.Node, depth int) ([][]CheckExplainStepModel, error) {
+	object, relation, ok := splitUserset(node.Name)
+	if !ok {
+		return nil, nil
+	}
+
+	paths := [][]CheckExplainStepModel{}
+
+	// continueWith extends the paths to the given userset by a step from that
+	// userset to the current node.
+	continueWith := func(userset string, type_ string) error {
+		subpaths, err := explainer.explainUserset(ctx, userset, depth+1)
+		if err != nil {
+			return err
+		}
+
+		for _, subpath := range subpaths {
+			paths = append(paths, append(append([]CheckExplainStepModel{}, subpath...), *NewCheckExplainStepModel(type_, userset, relation, object)))
+		}
+
+		return nil
+	}
+
+	switch {
+	case node.Leaf != nil:
+		if node.Leaf.Users != nil {
+			for _, user := range node.Leaf.Users.Users {
+				if explainer.matchesUser(user) {
+					paths = append(paths, []CheckExplainStepModel{*NewCheckExplainStepModel(CheckExplainStepTypeTuple, user, relation, object)})
+				} else if strings.Contains(user, "#") {
+					if err := continueWith(user, CheckExplainStepTypeTuple); err != nil {
+						return nil, err
+					}
+				}
+			}
+		}
+
+		if node.Leaf.Computed != nil {
+			if err := continueWith(node.Leaf.Computed.Userset, CheckExplainStepTypeComputedUserset); err != nil {
+				return nil, err
+			}
+		}
+
+		if node.Leaf.TupleToUserset != nil {
+			for _, computed := range node.Leaf.TupleToUserset.Computed {
+				if err := continueWith(computed.Userset, CheckExplainStepTypeTupleToUserset); err != nil {
+					return nil, err
+				}
+			}
+		}
+	case node.Union != nil:
+		for _, child := range node.Union.Nodes {
+			subpaths, err := explainer.explainNode(ctx, child, depth)
+			if err != nil {
+				return nil, err
+			}
+
+			paths = append(paths, subpaths...)
+		}
+	case node.Intersection != nil:
+		// Access requires a path through every operand, so all of them are
+		// reported, or none if any operand cannot be satisfied.
+		for _, child := range node.Intersection.Nodes {
+			subpaths, err := explainer.explainNode(ctx, child, depth)
+			if err != nil {
+				return nil, err
+			}
+
+			if len(subpaths) == 0 {
+				return nil, nil
+			}
+
+			paths = append(paths, subpaths...)
+		}
+	case node.Difference != nil:
+		subtracted, err := explainer.explainNode(ctx, node.Difference.Subtract, depth)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(subtracted) > 0 {
+			return nil, nil
+		}
+
+		return explainer.explainNode(ctx, node.Difference.Base, depth)
+	}
+
+	return paths, nil
+}
+
+// matchesUser returns whether the user of a relationship tuple is the queried
+// user, either directly or as a typed wildcard.
+func (explainer *checkExplainer) matchesUser(user string) bool {
+	if user == explainer.user {
+		return true
+	}
+
+	userType, found := strings.CutSuffix(user, ":*")
+
+	return found && !strings.Contains(explainer.user, "#") && strings.HasPrefix(explainer.user, userType+":")
+}
+
+func splitUserset(userset string) (string, string, bool) {
+	index := strings.LastIndex(userset, "#")
+	if index < 0 {
+		return "", "", false
+	}
+
+	return userset[:index], userset[index+1:], true
+}
@@ -0,0 +1,218 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/openfga/terraform-provider-openfga/internal/provider/providerdata"
+	"github.com/openfga/terraform-provider-openfga/internal/provider/relationshiptuple"
+)
+
+const defaultCheckExplainMaxDepth = 10
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CheckExplainDataSource{}
+var _ datasource.DataSourceWithConfigure = &CheckExplainDataSource{}
+
+func NewCheckExplainDataSource() datasource.DataSource {
+	return &CheckExplainDataSource{}
+}
+
+type CheckExplainDataSource struct {
+	client      *CheckExplainClient
+	queryClient *QueryClient
+}
+
+type CheckExplainDataSourceModel struct {
+	StoreId              types.String `tfsdk:"store_id"`
+	AuthorizationModelId types.String `tfsdk:"authorization_model_id"`
+
+	relationshiptuple.RelationshipTupleModel
+	MaxDepth types.Int64 `tfsdk:"max_depth"`
+	relationshiptuple.ConsistencyModel
+
+	Allowed   types.Bool              `tfsdk:"allowed"`
+	Paths     []CheckExplainPathModel `tfsdk:"paths"`
+	Truncated types.Bool              `tfsdk:"truncated"`
+}
+
+func (model CheckExplainDataSourceModel) GetMaxDepth() int {
+	if model.MaxDepth.IsNull() {
+		return defaultCheckExplainMaxDepth
+	}
+
+	return int(model.MaxDepth.ValueInt64())
+}
+
+func (d *CheckExplainDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_check_explain"
+}
+
+func (d *CheckExplainDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+A 'check explain' query establishes why a particular user has a specific relationship with a particular object.
+
+The userset trees of the relation are expanded recursively until the user is reached. Every chain of relationship tuples and rewrite rules granting access is reported as a path, e.g. ` + "`user:anne -> group:eng#member -> folder:x#viewer -> document:1#viewer`" + `. Conditions of relationship tuples are reported, but not evaluated.
+
+~> Usersets nested deeper than ` + "`max_depth`" + ` are not expanded. If this happens, ` + "`truncated`" + ` is ` + "`true`" + ` and ` + "`paths`" + ` may be incomplete.
+`,
+
+		Attributes: map[string]schema.Attribute{
+			"store_id": schema.StringAttribute{
+				MarkdownDescription: "The unique ID of the OpenFGA store this query is run against",
+				Required:            true,
+			},
+			"authorization_model_id": schema.StringAttribute{
+				MarkdownDescription: "The unique ID of the OpenFGA authorization model this query is run against",
+				Optional:            true,
+			},
+			"user": schema.StringAttribute{
+				MarkdownDescription: "The user of the query",
+				Required:            true,
+			},
+			"relation": schema.StringAttribute{
+				MarkdownDescription: "The relation to explain",
+				Required:            true,
+			},
+			"object": schema.StringAttribute{
+				MarkdownDescription: "The object of the query",
+				Required:            true,
+			},
+			"max_depth": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of usersets expanded along a single path. Paths exceeding it are omitted and `truncated` is set. Defaults to `10`",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"consistency": schema.StringAttribute{
+				MarkdownDescription: "The consistency preference of the query, either `MINIMIZE_LATENCY` or `HIGHER_CONSISTENCY`. Defaults to the consistency preference of the provider",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(relationshiptuple.ConsistencyValues...),
+				},
+			},
+			"allowed": schema.BoolAttribute{
+				MarkdownDescription: "Boolean value indicating whether the user has the relation to the object, as returned by a 'check' query",
+				Computed:            true,
+			},
+			"truncated": schema.BoolAttribute{
+				MarkdownDescription: "Boolean value indicating whether usersets exceeding `max_depth` were not expanded, i.e. whether `paths` may be incomplete",
+				Computed:            true,
+			},
+			"paths": schema.ListNestedAttribute{
+				MarkdownDescription: "The access paths from the user to the object. Empty if no path exists",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"chain": schema.StringAttribute{
+							MarkdownDescription: "The usersets of the path, starting with the user and ending with the queried relation of the object",
+							Computed:            true,
+						},
+						"steps": schema.ListNestedAttribute{
+							MarkdownDescription: "The steps of the path, ordered from the user to the object",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{
+										MarkdownDescription: "The type of the step, one of `tuple`, `computed_userset` or `tuple_to_userset`",
+										Computed:            true,
+									},
+									"user": schema.StringAttribute{
+										MarkdownDescription: "The user or userset of the step",
+										Computed:            true,
+									},
+									"relation": schema.StringAttribute{
+										MarkdownDescription: "The relation the step leads to",
+										Computed:            true,
+									},
+									"object": schema.StringAttribute{
+										MarkdownDescription: "The object the step leads to",
+										Computed:            true,
+									},
+									"condition": schema.SingleNestedAttribute{
+										MarkdownDescription: "The condition of the relationship tuple of a `tuple` step",
+										Computed:            true,
+										Attributes: map[string]schema.Attribute{
+											"name": schema.StringAttribute{
+												MarkdownDescription: "The name of the condition",
+												Computed:            true,
+											},
+											"context_json": schema.StringAttribute{
+												MarkdownDescription: "The (partial) context under which the condition is evaluated",
+												CustomType:          jsontypes.NormalizedType{},
+												Computed:            true,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CheckExplainDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providerdata.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = NewCheckExplainClient(providerData.Client, providerData.Consistency)
+	d.queryClient = NewQueryClient(providerData.Client, providerData.Consistency, providerData.QueryCache)
+}
+
+func (d *CheckExplainDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state CheckExplainDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	storeId := state.StoreId.ValueString()
+	authorizationModelId := state.AuthorizationModelId.ValueString()
+	consistency := state.Consistency.ValueString()
+
+	allowed, err := d.queryClient.Check(ctx, storeId, authorizationModelId, *NewCheckQueryModel(state.GetUser(), state.GetRelation(), state.GetObject(), nil, nil), consistency)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to perform check query, got error: %s", err))
+		return
+	}
+
+	paths, truncated, err := d.client.Explain(ctx, storeId, authorizationModelId, state.RelationshipTupleModel, state.GetMaxDepth(), consistency)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to explain check query, got error: %s", err))
+		return
+	}
+
+	state.Allowed = allowed
+	state.Paths = paths
+	state.Truncated = types.BoolValue(truncated)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
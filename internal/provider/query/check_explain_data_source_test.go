@@ -0,0 +1,214 @@
+package query_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/openfga/terraform-provider-openfga/internal/provider/acceptance"
+)
+
+func TestAccCheckExplainDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccCheckExplainDataSourceConfig(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.openfga_check_explain.indirect",
+						tfjsonpath.New("allowed"),
+						knownvalue.Bool(true),
+					),
+					statecheck.ExpectKnownValue(
+						"data.openfga_check_explain.indirect",
+						tfjsonpath.New("paths"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.ObjectPartial(map[string]knownvalue.Check{
+								"chain": knownvalue.StringExact("user:anne -> group:eng#member -> folder:x#viewer -> document:1#viewer"),
+								"steps": knownvalue.ListExact([]knownvalue.Check{
+									knownvalue.ObjectExact(map[string]knownvalue.Check{
+										"type":      knownvalue.StringExact("tuple"),
+										"user":      knownvalue.StringExact("user:anne"),
+										"relation":  knownvalue.StringExact("member"),
+										"object":    knownvalue.StringExact("group:eng"),
+										"condition": knownvalue.Null(),
+									}),
+									knownvalue.ObjectExact(map[string]knownvalue.Check{
+										"type":      knownvalue.StringExact("tuple"),
+										"user":      knownvalue.StringExact("group:eng#member"),
+										"relation":  knownvalue.StringExact("viewer"),
+										"object":    knownvalue.StringExact("folder:x"),
+										"condition": knownvalue.Null(),
+									}),
+									knownvalue.ObjectExact(map[string]knownvalue.Check{
+										"type":      knownvalue.StringExact("tuple_to_userset"),
+										"user":      knownvalue.StringExact("folder:x#viewer"),
+										"relation":  knownvalue.StringExact("viewer"),
+										"object":    knownvalue.StringExact("document:1"),
+										"condition": knownvalue.Null(),
+									}),
+								}),
+							}),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"data.openfga_check_explain.indirect",
+						tfjsonpath.New("truncated"),
+						knownvalue.Bool(false),
+					),
+					statecheck.ExpectKnownValue(
+						"data.openfga_check_explain.computed",
+						tfjsonpath.New("paths"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.ObjectPartial(map[string]knownvalue.Check{
+								"chain": knownvalue.StringExact("user:bob -> document:1#editor -> document:1#viewer"),
+							}),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"data.openfga_check_explain.forbidden",
+						tfjsonpath.New("allowed"),
+						knownvalue.Bool(false),
+					),
+					statecheck.ExpectKnownValue(
+						"data.openfga_check_explain.forbidden",
+						tfjsonpath.New("paths"),
+						knownvalue.ListSizeExact(0),
+					),
+					statecheck.ExpectKnownValue(
+						"data.openfga_check_explain.forbidden",
+						tfjsonpath.New("truncated"),
+						knownvalue.Bool(false),
+					),
+					statecheck.ExpectKnownValue(
+						"data.openfga_check_explain.shallow",
+						tfjsonpath.New("allowed"),
+						knownvalue.Bool(true),
+					),
+					statecheck.ExpectKnownValue(
+						"data.openfga_check_explain.shallow",
+						tfjsonpath.New("paths"),
+						knownvalue.ListSizeExact(0),
+					),
+					statecheck.ExpectKnownValue(
+						"data.openfga_check_explain.shallow",
+						tfjsonpath.New("truncated"),
+						knownvalue.Bool(true),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckExplainDataSourceConfig() string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "openfga_store" "test" {
+	name = "test"
+}
+
+data "openfga_authorization_model_document" "test" {
+	dsl = <<EOT
+model
+	schema 1.1
+
+type user
+
+type group
+	relations
+		define member: [user]
+
+type folder
+	relations
+		define viewer: [user, group#member]
+
+type document
+	relations
+		define parent: [folder]
+		define editor: [user]
+		define viewer: [user] or editor or viewer from parent
+	EOT
+}
+
+resource "openfga_authorization_model" "test" {
+	store_id = openfga_store.test.id
+
+	model_json = data.openfga_authorization_model_document.test.result
+}
+
+locals {
+	tuples = [
+		{ user = "user:anne", relation = "member", object = "group:eng" },
+		{ user = "group:eng#member", relation = "viewer", object = "folder:x" },
+		{ user = "folder:x", relation = "parent", object = "document:1" },
+		{ user = "user:bob", relation = "editor", object = "document:1" },
+	]
+}
+
+resource "openfga_relationship_tuple" "test" {
+	count = length(local.tuples)
+
+	store_id               = openfga_store.test.id
+	authorization_model_id = openfga_authorization_model.test.id
+
+	user     = local.tuples[count.index].user
+	relation = local.tuples[count.index].relation
+	object   = local.tuples[count.index].object
+}
+
+data "openfga_check_explain" "indirect" {
+	depends_on = [openfga_relationship_tuple.test]
+
+	store_id               = openfga_store.test.id
+	authorization_model_id = openfga_authorization_model.test.id
+
+	user     = "user:anne"
+	relation = "viewer"
+	object   = "document:1"
+}
+
+data "openfga_check_explain" "computed" {
+	depends_on = [openfga_relationship_tuple.test]
+
+	store_id               = openfga_store.test.id
+	authorization_model_id = openfga_authorization_model.test.id
+
+	user     = "user:bob"
+	relation = "viewer"
+	object   = "document:1"
+}
+
+data "openfga_check_explain" "forbidden" {
+	depends_on = [openfga_relationship_tuple.test]
+
+	store_id               = openfga_store.test.id
+	authorization_model_id = openfga_authorization_model.test.id
+
+	user     = "user:carol"
+	relation = "viewer"
+	object   = "document:1"
+}
+
+data "openfga_check_explain" "shallow" {
+	depends_on = [openfga_relationship_tuple.test]
+
+	store_id               = openfga_store.test.id
+	authorization_model_id = openfga_authorization_model.test.id
+
+	user     = "user:anne"
+	relation = "viewer"
+	object   = "document:1"
+
+	max_depth = 1
+}
+`, acceptance.ProviderConfig)
+}
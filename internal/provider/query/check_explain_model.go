@@ -0,0 +1,58 @@
+package query
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/openfga/terraform-provider-openfga/internal/provider/relationshiptuple"
+)
+
+const (
+	CheckExplainStepTypeTuple           = "tuple"
+	CheckExplainStepTypeComputedUserset = "computed_userset"
+	CheckExplainStepTypeTupleToUserset  = "tuple_to_userset"
+)
+
+// CheckExplainStepModel is a single edge of an access path. The user of the
+// step is related to the object of the step, either by a relationship tuple
+// or by a rewrite rule of the authorization model.
+type CheckExplainStepModel struct {
+	Type types.String `tfsdk:"type"`
+	relationshiptuple.RelationshipTupleWithConditionModel
+}
+
+func (model CheckExplainStepModel) GetType() string {
+	return model.Type.ValueString()
+}
+
+// GetUserset returns the userset the step leads to, e.g. `document:1#viewer`.
+func (model CheckExplainStepModel) GetUserset() string {
+	return model.GetObject() + "#" + model.GetRelation()
+}
+
+func NewCheckExplainStepModel(type_ string, user string, relation string, object string) *CheckExplainStepModel {
+	return &CheckExplainStepModel{
+		Type:                                types.StringValue(type_),
+		RelationshipTupleWithConditionModel: *relationshiptuple.NewRelationshipTupleWithConditionModel(user, relation, object, nil),
+	}
+}
+
+type CheckExplainPathModel struct {
+	Chain types.String            `tfsdk:"chain"`
+	Steps []CheckExplainStepModel `tfsdk:"steps"`
+}
+
+// NewCheckExplainPathModel creates an access path of the given user from its
+// steps, which are ordered from the user to the queried object.
+func NewCheckExplainPathModel(user string, steps []CheckExplainStepModel) *CheckExplainPathModel {
+	chain := []string{user}
+	for _, step := range steps {
+		chain = append(chain, step.GetUserset())
+	}
+
+	return &CheckExplainPathModel{
+		Chain: types.StringValue(strings.Join(chain, " -> ")),
+		Steps: steps,
+	}
+}
@@ -5,11 +5,20 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
-	"github.com/openfga/go-sdk/client"
+	"github.com/openfga/terraform-provider-openfga/internal/provider/providerdata"
+	"github.com/openfga/terraform-provider-openfga/internal/provider/relationshiptuple"
+)
+
+const (
+	CheckQuerySeverityError   = "error"
+	CheckQuerySeverityWarning = "warning"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -29,6 +38,9 @@ type CheckQueryDataSourceModel struct {
 	AuthorizationModelId types.String `tfsdk:"authorization_model_id"`
 
 	CheckQueryModel
+	relationshiptuple.ConsistencyModel
+	Expect   types.Bool   `tfsdk:"expect"`
+	Severity types.String `tfsdk:"severity"`
 
 	Result types.Bool `tfsdk:"result"`
 }
@@ -102,6 +114,25 @@ func (d *CheckQueryDataSource) Schema(ctx context.Context, req datasource.Schema
 				CustomType:          jsontypes.NormalizedType{},
 				Optional:            true,
 			},
+			"consistency": schema.StringAttribute{
+				MarkdownDescription: "The consistency preference of the query, either `MINIMIZE_LATENCY` or `HIGHER_CONSISTENCY`. Defaults to the consistency preference of the provider",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(relationshiptuple.ConsistencyValues...),
+				},
+			},
+			"expect": schema.BoolAttribute{
+				MarkdownDescription: "The expected result of the query. If the result differs, a diagnostic with the given `severity` is raised, e.g. to block an apply",
+				Optional:            true,
+			},
+			"severity": schema.StringAttribute{
+				MarkdownDescription: "The severity of the diagnostic raised if the result differs from `expect`, either `error` or `warning`. Defaults to `error`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(CheckQuerySeverityError, CheckQuerySeverityWarning),
+					stringvalidator.AlsoRequires(path.MatchRoot("expect")),
+				},
+			},
 			"result": schema.BoolAttribute{
 				MarkdownDescription: "Boolean value indicating whether the user has a relation to the object",
 				Computed:            true,
@@ -116,18 +147,18 @@ func (d *CheckQueryDataSource) Configure(ctx context.Context, req datasource.Con
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.OpenFgaClient)
+	providerData, ok := req.ProviderData.(*providerdata.ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.OpenFgaClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	d.client = NewQueryClient(client)
+	d.client = NewQueryClient(providerData.Client, providerData.Consistency, providerData.QueryCache)
 }
 
 func (d *CheckQueryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -139,7 +170,7 @@ func (d *CheckQueryDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
-	result, err := d.client.Check(ctx, state.StoreId.ValueString(), state.AuthorizationModelId.ValueString(), state.CheckQueryModel)
+	result, err := d.client.Check(ctx, state.StoreId.ValueString(), state.AuthorizationModelId.ValueString(), state.CheckQueryModel, state.Consistency.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to perform check query, got error: %s", err))
 		return
@@ -147,5 +178,16 @@ func (d *CheckQueryDataSource) Read(ctx context.Context, req datasource.ReadRequ
 
 	state.Result = result
 
+	if !state.Expect.IsNull() && state.Expect.ValueBool() != result.ValueBool() {
+		summary := "Unexpected Check Result"
+		detail := fmt.Sprintf("Check query (%s) returned %t, expected %t", state.CheckQueryModel, result.ValueBool(), state.Expect.ValueBool())
+
+		if state.Severity.ValueString() == CheckQuerySeverityWarning {
+			resp.Diagnostics.AddWarning(summary, detail)
+		} else {
+			resp.Diagnostics.AddError(summary, detail)
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
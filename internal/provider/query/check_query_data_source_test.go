@@ -2,6 +2,7 @@ package query_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -52,6 +53,172 @@ func TestAccCheckQueryDataSource(t *testing.T) {
 	})
 }
 
+func TestAccCheckQueryDataSourceExpect(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Expected result testing
+			{
+				Config: testAccCheckQueryDataSourceExpectConfig(true, "error"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.openfga_check_query.test",
+						tfjsonpath.New("result"),
+						knownvalue.Bool(true),
+					),
+				},
+			},
+			// Unexpected result warning testing
+			{
+				Config: testAccCheckQueryDataSourceExpectConfig(false, "warning"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.openfga_check_query.test",
+						tfjsonpath.New("result"),
+						knownvalue.Bool(true),
+					),
+				},
+			},
+			// Unexpected result error testing
+			{
+				Config:      testAccCheckQueryDataSourceExpectConfig(false, "error"),
+				ExpectError: regexp.MustCompile(`(?s)Unexpected Check Result.*contextual_tuples=\[user:user-1 viewer\s+document:document-1\].*returned true,\s+expected false`),
+			},
+		},
+	})
+}
+
+func TestAccCheckQueryDataSourceQueryCache(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid TTL testing
+			{
+				Config:      testAccCheckQueryDataSourceQueryCacheConfig("invalid"),
+				ExpectError: regexp.MustCompile("Invalid Query Cache TTL"),
+			},
+			// Cached query testing
+			{
+				Config: testAccCheckQueryDataSourceQueryCacheConfig("1m"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.openfga_check_query.test[0]",
+						tfjsonpath.New("result"),
+						knownvalue.Bool(true),
+					),
+					statecheck.ExpectKnownValue(
+						"data.openfga_check_query.test[1]",
+						tfjsonpath.New("result"),
+						knownvalue.Bool(true),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckQueryDataSourceQueryCacheConfig(ttl string) string {
+	return fmt.Sprintf(`
+provider "openfga" {
+	api_url = %[1]q
+
+	query_cache = {
+		ttl         = %[2]q
+		max_entries = 10
+	}
+}
+
+resource "openfga_store" "test" {
+	name = "test"
+}
+
+data "openfga_authorization_model_document" "test" {
+	dsl = <<EOT
+model
+	schema 1.1
+
+type user
+
+type document
+	relations
+		define viewer: [user]
+	EOT
+}
+
+resource "openfga_authorization_model" "test" {
+	store_id = openfga_store.test.id
+
+	model_json = data.openfga_authorization_model_document.test.result
+}
+
+data "openfga_check_query" "test" {
+	count = 2
+
+	store_id = openfga_store.test.id
+	authorization_model_id = openfga_authorization_model.test.id
+
+	user     = "user:user-1"
+	relation = "viewer"
+	object   = "document:document-1"
+
+	contextual_tuples = [{
+		user     = "user:user-1"
+		relation = "viewer"
+		object   = "document:document-1"
+	}]
+}
+`, acceptance.ProviderApiUrl, ttl)
+}
+
+func testAccCheckQueryDataSourceExpectConfig(expect bool, severity string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "openfga_store" "test" {
+	name = "test"
+}
+
+data "openfga_authorization_model_document" "test" {
+	dsl = <<EOT
+model
+	schema 1.1
+
+type user
+
+type document
+	relations
+		define viewer: [user]
+	EOT
+}
+
+resource "openfga_authorization_model" "test" {
+	store_id = openfga_store.test.id
+
+	model_json = data.openfga_authorization_model_document.test.result
+}
+
+data "openfga_check_query" "test" {
+	store_id = openfga_store.test.id
+	authorization_model_id = openfga_authorization_model.test.id
+
+	user     = "user:user-1"
+	relation = "viewer"
+	object   = "document:document-1"
+
+	contextual_tuples = [{
+		user     = "user:user-1"
+		relation = "viewer"
+		object   = "document:document-1"
+	}]
+
+	expect   = %[2]t
+	severity = %[3]q
+}
+`, acceptance.ProviderConfig, expect, severity)
+}
+
 func testAccCheckQueryDataSourceConfig() string {
 	return fmt.Sprintf(`
 %[1]s
@@ -101,6 +268,8 @@ data "openfga_check_query" "allowed" {
 	user     = "user:user-1"
 	relation = "viewer"
 	object   = "document:document-1"
+
+	consistency = "HIGHER_CONSISTENCY"
 }
 
 data "openfga_check_query" "forbidden" {
@@ -1,6 +1,9 @@
 package query
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/openfga/terraform-provider-openfga/internal/provider/relationshiptuple"
 )
 
@@ -18,6 +21,35 @@ func (query CheckQueryModel) GetContextualTuples() []relationshiptuple.Relations
 	return *query.ContextualTuples
 }
 
+// String describes the query including its contextual tuples and context, e.g.
+// for diagnostics.
+func (query CheckQueryModel) String() string {
+	description := fmt.Sprintf("user=%s, relation=%s, object=%s", query.GetUser(), query.GetRelation(), query.GetObject())
+
+	if len(query.GetContextualTuples()) > 0 {
+		contextualTuples := []string{}
+		for _, contextualTuple := range query.GetContextualTuples() {
+			contextualTupleDescription := fmt.Sprintf("%s %s %s", contextualTuple.GetUser(), contextualTuple.GetRelation(), contextualTuple.GetObject())
+			if condition := contextualTuple.GetCondition(); condition != nil {
+				contextualTupleDescription += fmt.Sprintf(" with %s", condition.GetName())
+				if condition.GetContextJson() != "" {
+					contextualTupleDescription += fmt.Sprintf(" %s", condition.GetContextJson())
+				}
+			}
+
+			contextualTuples = append(contextualTuples, contextualTupleDescription)
+		}
+
+		description += fmt.Sprintf(", contextual_tuples=[%s]", strings.Join(contextualTuples, "; "))
+	}
+
+	if query.GetContextJson() != "" {
+		description += fmt.Sprintf(", context=%s", query.GetContextJson())
+	}
+
+	return description
+}
+
 func NewCheckQueryModel(user string, relation string, object string, contextualTuples *[]relationshiptuple.RelationshipTupleWithConditionModel, context *map[string]interface{}) *CheckQueryModel {
 	return &CheckQueryModel{
 		RelationshipTupleModel: *relationshiptuple.NewRelationshipTupleModel(user, relation, object),
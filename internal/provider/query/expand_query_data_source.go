@@ -0,0 +1,178 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/openfga/terraform-provider-openfga/internal/provider/providerdata"
+	"github.com/openfga/terraform-provider-openfga/internal/provider/relationshiptuple"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ExpandQueryDataSource{}
+var _ datasource.DataSourceWithConfigure = &ExpandQueryDataSource{}
+
+func NewExpandQueryDataSource() datasource.DataSource {
+	return &ExpandQueryDataSource{}
+}
+
+type ExpandQueryDataSource struct {
+	client *QueryClient
+}
+
+type ExpandQueryDataSourceModel struct {
+	StoreId              types.String `tfsdk:"store_id"`
+	AuthorizationModelId types.String `tfsdk:"authorization_model_id"`
+
+	ExpandQueryModel
+	relationshiptuple.ConsistencyModel
+
+	ExpandResultModel
+}
+
+func (d *ExpandQueryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_expand_query"
+}
+
+func (d *ExpandQueryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "An 'expand' query can be performed to retrieve the userset tree of a relation on a particular object. It shows how the relation is resolved and can be used to debug and document who effectively has a specific relationship with the object.",
+
+		Attributes: map[string]schema.Attribute{
+			"store_id": schema.StringAttribute{
+				MarkdownDescription: "The unique ID of the OpenFGA store this query is run against",
+				Required:            true,
+			},
+			"authorization_model_id": schema.StringAttribute{
+				MarkdownDescription: "The unique ID of the OpenFGA authorization model this query is run against",
+				Optional:            true,
+			},
+			"relation": schema.StringAttribute{
+				MarkdownDescription: "The relation to expand",
+				Required:            true,
+			},
+			"object": schema.StringAttribute{
+				MarkdownDescription: "The object of the query",
+				Required:            true,
+			},
+			"contextual_tuples": schema.ListNestedAttribute{
+				MarkdownDescription: "The contextual tuples that should be considered for the query",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"user": schema.StringAttribute{
+							MarkdownDescription: "The user of the contextual relationship tuple",
+							Required:            true,
+						},
+						"relation": schema.StringAttribute{
+							MarkdownDescription: "The relation of the contextual relationship tuple",
+							Required:            true,
+						},
+						"object": schema.StringAttribute{
+							MarkdownDescription: "The object of the contextual relationship tuple",
+							Required:            true,
+						},
+						"condition": schema.SingleNestedAttribute{
+							MarkdownDescription: "A condition of the contextual relationship tuple",
+							Optional:            true,
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									MarkdownDescription: "The name of the condition",
+									Required:            true,
+								},
+								"context_json": schema.StringAttribute{
+									MarkdownDescription: "The (partial) context under which the condition is evaluated",
+									CustomType:          jsontypes.NormalizedType{},
+									Optional:            true,
+								},
+							},
+						},
+					},
+				},
+			},
+			"consistency": schema.StringAttribute{
+				MarkdownDescription: "The consistency preference of the query, either `MINIMIZE_LATENCY` or `HIGHER_CONSISTENCY`. Defaults to the consistency preference of the provider",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(relationshiptuple.ConsistencyValues...),
+				},
+			},
+			"tree_json": schema.StringAttribute{
+				MarkdownDescription: "The userset tree of the relation as nested JSON",
+				CustomType:          jsontypes.NormalizedType{},
+				Computed:            true,
+			},
+			"leaves": schema.ListNestedAttribute{
+				MarkdownDescription: "The leaves of the userset tree, flattened in depth-first order",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"node": schema.StringAttribute{
+							MarkdownDescription: "The name of the tree node the leaf belongs to",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the leaf, one of `user`, `computed_userset` or `tuple_to_userset`",
+							Computed:            true,
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "The user or userset of the leaf",
+							Computed:            true,
+						},
+						"subtracted": schema.BoolAttribute{
+							MarkdownDescription: "Whether the leaf is part of the subtracted side of a difference, i.e. it excludes rather than grants the relation",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ExpandQueryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providerdata.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = NewQueryClient(providerData.Client, providerData.Consistency, providerData.QueryCache)
+}
+
+func (d *ExpandQueryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ExpandQueryDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Expand(ctx, state.StoreId.ValueString(), state.AuthorizationModelId.ValueString(), state.ExpandQueryModel, state.Consistency.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to perform expand query, got error: %s", err))
+		return
+	}
+
+	state.ExpandResultModel = *result
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
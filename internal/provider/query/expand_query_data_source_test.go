@@ -0,0 +1,135 @@
+package query_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/openfga/terraform-provider-openfga/internal/provider/acceptance"
+)
+
+func TestAccExpandQueryDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccExpandQueryDataSourceConfig(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.openfga_expand_query.test",
+						tfjsonpath.New("tree_json"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"data.openfga_expand_query.test",
+						tfjsonpath.New("leaves"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"node":       knownvalue.StringExact("document:document-1#viewer"),
+								"type":       knownvalue.StringExact("user"),
+								"value":      knownvalue.StringExact("user:user-1"),
+								"subtracted": knownvalue.Bool(false),
+							}),
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"node":       knownvalue.StringExact("document:document-1#viewer"),
+								"type":       knownvalue.StringExact("computed_userset"),
+								"value":      knownvalue.StringExact("document:document-1#editor"),
+								"subtracted": knownvalue.Bool(false),
+							}),
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"node":       knownvalue.StringExact("document:document-1#viewer"),
+								"type":       knownvalue.StringExact("computed_userset"),
+								"value":      knownvalue.StringExact("document:document-1#blocked"),
+								"subtracted": knownvalue.Bool(true),
+							}),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"data.openfga_expand_query.contextual",
+						tfjsonpath.New("leaves"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"node":       knownvalue.StringExact("document:document-1#editor"),
+								"type":       knownvalue.StringExact("user"),
+								"value":      knownvalue.StringExact("user:user-2"),
+								"subtracted": knownvalue.Bool(false),
+							}),
+						}),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccExpandQueryDataSourceConfig() string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "openfga_store" "test" {
+	name = "test"
+}
+
+data "openfga_authorization_model_document" "test" {
+	dsl = <<EOT
+model
+	schema 1.1
+
+type user
+
+type document
+	relations
+		define blocked: [user]
+		define editor: [user]
+		define viewer: ([user] or editor) but not blocked
+	EOT
+}
+
+resource "openfga_authorization_model" "test" {
+	store_id = openfga_store.test.id
+
+	model_json = data.openfga_authorization_model_document.test.result
+}
+
+resource "openfga_relationship_tuple" "test" {
+	store_id               = openfga_store.test.id
+	authorization_model_id = openfga_authorization_model.test.id
+
+	user      = "user:user-1"
+	relation  = "viewer"
+	object    = "document:document-1"
+}
+
+data "openfga_expand_query" "test" {
+	depends_on = [openfga_relationship_tuple.test]
+
+	store_id = openfga_store.test.id
+	authorization_model_id = openfga_authorization_model.test.id
+
+	relation = "viewer"
+	object   = "document:document-1"
+}
+
+data "openfga_expand_query" "contextual" {
+	depends_on = [openfga_relationship_tuple.test]
+
+	store_id = openfga_store.test.id
+	authorization_model_id = openfga_authorization_model.test.id
+
+	relation = "editor"
+	object   = "document:document-1"
+
+	contextual_tuples = [{
+		user     = "user:user-2"
+		relation = "editor"
+		object   = "document:document-1"
+	}]
+}
+`, acceptance.ProviderConfig)
+}
@@ -0,0 +1,127 @@
+package query
+
+import (
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	openfga "github.com/openfga/go-sdk"
+
+	"github.com/openfga/terraform-provider-openfga/internal/provider/relationshiptuple"
+)
+
+const (
+	ExpandLeafTypeUser            = "user"
+	ExpandLeafTypeComputedUserset = "computed_userset"
+	ExpandLeafTypeTupleToUserset  = "tuple_to_userset"
+)
+
+type ExpandQueryModel struct {
+	Relation         types.String                                             `tfsdk:"relation"`
+	Object           types.String                                             `tfsdk:"object"`
+	ContextualTuples *[]relationshiptuple.RelationshipTupleWithConditionModel `tfsdk:"contextual_tuples"`
+}
+
+func (query ExpandQueryModel) GetRelation() string {
+	return query.Relation.ValueString()
+}
+
+func (query ExpandQueryModel) GetObject() string {
+	return query.Object.ValueString()
+}
+
+func (query ExpandQueryModel) GetContextualTuples() []relationshiptuple.RelationshipTupleWithConditionModel {
+	if query.ContextualTuples == nil {
+		return []relationshiptuple.RelationshipTupleWithConditionModel{}
+	}
+
+	return *query.ContextualTuples
+}
+
+func NewExpandQueryModel(relation string, object string, contextualTuples *[]relationshiptuple.RelationshipTupleWithConditionModel) *ExpandQueryModel {
+	return &ExpandQueryModel{
+		Relation:         types.StringValue(relation),
+		Object:           types.StringValue(object),
+		ContextualTuples: contextualTuples,
+	}
+}
+
+type ExpandLeafModel struct {
+	Node       types.String `tfsdk:"node"`
+	Type       types.String `tfsdk:"type"`
+	Value      types.String `tfsdk:"value"`
+	Subtracted types.Bool   `tfsdk:"subtracted"`
+}
+
+func NewExpandLeafModel(node string, type_ string, value string, subtracted bool) *ExpandLeafModel {
+	return &ExpandLeafModel{
+		Node:       types.StringValue(node),
+		Type:       types.StringValue(type_),
+		Value:      types.StringValue(value),
+		Subtracted: types.BoolValue(subtracted),
+	}
+}
+
+type ExpandResultModel struct {
+	TreeJson jsontypes.Normalized `tfsdk:"tree_json"`
+	Leaves   []ExpandLeafModel    `tfsdk:"leaves"`
+}
+
+func NewExpandResultModel(tree openfga.UsersetTree) (*ExpandResultModel, error) {
+	treeJson, err := json.Marshal(tree)
+	if err != nil {
+		return nil, err
+	}
+
+	leaves := []ExpandLeafModel{}
+	if tree.Root != nil {
+		leaves = flattenExpandNode(*tree.Root, false, leaves)
+	}
+
+	return &ExpandResultModel{
+		TreeJson: jsontypes.NewNormalizedValue(string(treeJson)),
+		Leaves:   leaves,
+	}, nil
+}
+
+// flattenExpandNode collects the leaves of the given userset tree node in
+// depth-first order. Leaves below the subtracted side of a difference are
+// marked, as they deny rather than grant the relation.
+func flattenExpandNode(node openfga.Node, subtracted bool, leaves []ExpandLeafModel) []ExpandLeafModel {
+	if node.Leaf != nil {
+		if node.Leaf.Users != nil {
+			for _, user := range node.Leaf.Users.Users {
+				leaves = append(leaves, *NewExpandLeafModel(node.Name, ExpandLeafTypeUser, user, subtracted))
+			}
+		}
+
+		if node.Leaf.Computed != nil {
+			leaves = append(leaves, *NewExpandLeafModel(node.Name, ExpandLeafTypeComputedUserset, node.Leaf.Computed.Userset, subtracted))
+		}
+
+		if node.Leaf.TupleToUserset != nil {
+			for _, computed := range node.Leaf.TupleToUserset.Computed {
+				leaves = append(leaves, *NewExpandLeafModel(node.Name, ExpandLeafTypeTupleToUserset, computed.Userset, subtracted))
+			}
+		}
+	}
+
+	if node.Union != nil {
+		for _, child := range node.Union.Nodes {
+			leaves = flattenExpandNode(child, subtracted, leaves)
+		}
+	}
+
+	if node.Intersection != nil {
+		for _, child := range node.Intersection.Nodes {
+			leaves = flattenExpandNode(child, subtracted, leaves)
+		}
+	}
+
+	if node.Difference != nil {
+		leaves = flattenExpandNode(node.Difference.Base, subtracted, leaves)
+		leaves = flattenExpandNode(node.Difference.Subtract, !subtracted, leaves)
+	}
+
+	return leaves
+}
@@ -3,13 +3,19 @@ package query
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
-	"github.com/openfga/go-sdk/client"
+	"github.com/openfga/terraform-provider-openfga/internal/provider/providerdata"
+	"github.com/openfga/terraform-provider-openfga/internal/provider/relationshiptuple"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -29,8 +35,23 @@ type ListObjectsQueryDataSourceModel struct {
 	AuthorizationModelId types.String `tfsdk:"authorization_model_id"`
 
 	ListObjectsQueryModel
+	relationshiptuple.ConsistencyModel
+	MaxResults types.Int64  `tfsdk:"max_results"`
+	Timeout    types.String `tfsdk:"timeout"`
 
-	Result types.List `tfsdk:"result"`
+	ListObjectsResultModel
+}
+
+func (model ListObjectsQueryDataSourceModel) GetMaxResults() int {
+	return int(model.MaxResults.ValueInt64())
+}
+
+func (model ListObjectsQueryDataSourceModel) GetTimeout() (time.Duration, error) {
+	if model.Timeout.IsNull() {
+		return 0, nil
+	}
+
+	return time.ParseDuration(model.Timeout.ValueString())
 }
 
 func (d *ListObjectsQueryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -39,7 +60,11 @@ func (d *ListObjectsQueryDataSource) Metadata(ctx context.Context, req datasourc
 
 func (d *ListObjectsQueryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "A 'list objects' query can be performed to establish which objects a particular user has a specific relationship.",
+		MarkdownDescription: `
+A 'list objects' query can be performed to establish which objects a particular user has a specific relationship.
+
+~> **Breaking change:** The ` + "`result`" + ` is a set instead of a list and can not be indexed anymore. Convert it into a sorted list with ` + "`sort()`" + ` or ` + "`tolist()`" + ` to access single objects, e.g. ` + "`sort(data.openfga_list_objects_query.example.result)[0]`" + `.
+`,
 
 		Attributes: map[string]schema.Attribute{
 			"store_id": schema.StringAttribute{
@@ -102,11 +127,33 @@ func (d *ListObjectsQueryDataSource) Schema(ctx context.Context, req datasource.
 				CustomType:          jsontypes.NormalizedType{},
 				Optional:            true,
 			},
-			"result": schema.ListAttribute{
-				MarkdownDescription: "A list of objects the user is related with",
+			"consistency": schema.StringAttribute{
+				MarkdownDescription: "The consistency preference of the query, either `MINIMIZE_LATENCY` or `HIGHER_CONSISTENCY`. Defaults to the consistency preference of the provider",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(relationshiptuple.ConsistencyValues...),
+				},
+			},
+			"max_results": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of objects to return. All objects are returned if not set",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"timeout": schema.StringAttribute{
+				MarkdownDescription: "The maximum duration of the query, e.g. `30s` or `2m`. The objects received until then are returned, but not kept in the query cache of the provider. No timeout applies if not set",
+				Optional:            true,
+			},
+			"result": schema.SetAttribute{
+				MarkdownDescription: "A set of objects the user is related with. Use `sort()` or `tolist()` to convert it into a list",
 				ElementType:         types.StringType,
 				Computed:            true,
 			},
+			"truncated": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user is related with further objects than `max_results`, or the `timeout` was reached, i.e. the user might be related with further objects",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -117,18 +164,18 @@ func (d *ListObjectsQueryDataSource) Configure(ctx context.Context, req datasour
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.OpenFgaClient)
+	providerData, ok := req.ProviderData.(*providerdata.ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.OpenFgaClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	d.client = NewQueryClient(client)
+	d.client = NewQueryClient(providerData.Client, providerData.Consistency, providerData.QueryCache)
 }
 
 func (d *ListObjectsQueryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -140,13 +187,19 @@ func (d *ListObjectsQueryDataSource) Read(ctx context.Context, req datasource.Re
 		return
 	}
 
-	result, err := d.client.ListObjects(ctx, state.StoreId.ValueString(), state.AuthorizationModelId.ValueString(), state.ListObjectsQueryModel)
+	timeout, err := state.GetTimeout()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("timeout"), "Invalid Timeout", fmt.Sprintf("Unable to parse timeout, got error: %s", err))
+		return
+	}
+
+	result, err := d.client.ListObjects(ctx, state.StoreId.ValueString(), state.AuthorizationModelId.ValueString(), state.ListObjectsQueryModel, state.Consistency.ValueString(), state.GetMaxResults(), timeout)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to perform list objects query, got error: %s", err))
 		return
 	}
 
-	state.Result = result
+	state.ListObjectsResultModel = *result
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
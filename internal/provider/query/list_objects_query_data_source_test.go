@@ -24,33 +24,60 @@ func TestAccListObjectsDataSource(t *testing.T) {
 					statecheck.ExpectKnownValue(
 						"data.openfga_list_objects_query.with_results",
 						tfjsonpath.New("result"),
-						knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.SetExact([]knownvalue.Check{
 							knownvalue.StringExact("document:document-1"),
+							knownvalue.StringExact("document:document-2"),
+							knownvalue.StringExact("document:document-3"),
 						}),
 					),
+					statecheck.ExpectKnownValue(
+						"data.openfga_list_objects_query.with_results",
+						tfjsonpath.New("truncated"),
+						knownvalue.Bool(false),
+					),
+					statecheck.ExpectKnownValue(
+						"data.openfga_list_objects_query.with_max_results",
+						tfjsonpath.New("result"),
+						knownvalue.SetSizeExact(2),
+					),
+					statecheck.ExpectKnownValue(
+						"data.openfga_list_objects_query.with_max_results",
+						tfjsonpath.New("truncated"),
+						knownvalue.Bool(true),
+					),
+					statecheck.ExpectKnownValue(
+						"data.openfga_list_objects_query.with_all_results",
+						tfjsonpath.New("result"),
+						knownvalue.SetSizeExact(3),
+					),
+					statecheck.ExpectKnownValue(
+						"data.openfga_list_objects_query.with_all_results",
+						tfjsonpath.New("truncated"),
+						knownvalue.Bool(false),
+					),
 					statecheck.ExpectKnownValue(
 						"data.openfga_list_objects_query.without_results",
 						tfjsonpath.New("result"),
-						knownvalue.ListSizeExact(0),
+						knownvalue.SetSizeExact(0),
 					),
 					statecheck.ExpectKnownValue(
 						"data.openfga_list_objects_query.with_contextual_results",
 						tfjsonpath.New("result"),
-						knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.SetExact([]knownvalue.Check{
 							knownvalue.StringExact("document:document-1"),
 						}),
 					),
 					statecheck.ExpectKnownValue(
 						"data.openfga_list_objects_query.with_contextual_context_results",
 						tfjsonpath.New("result"),
-						knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.SetExact([]knownvalue.Check{
 							knownvalue.StringExact("document:document-1"),
 						}),
 					),
 					statecheck.ExpectKnownValue(
 						"data.openfga_list_objects_query.without_contextual_context_results",
 						tfjsonpath.New("result"),
-						knownvalue.ListSizeExact(0),
+						knownvalue.SetSizeExact(0),
 					),
 				},
 			},
@@ -90,12 +117,41 @@ resource "openfga_authorization_model" "test" {
 }
 
 resource "openfga_relationship_tuple" "test" {
+	count = 3
+
 	store_id               = openfga_store.test.id
 	authorization_model_id = openfga_authorization_model.test.id
 
 	user      = "user:user-1"
 	relation  = "viewer"
-	object    = "document:document-1"
+	object    = "document:document-${count.index + 1}"
+}
+
+data "openfga_list_objects_query" "with_max_results" {
+	depends_on = [openfga_relationship_tuple.test]
+
+	store_id = openfga_store.test.id
+	authorization_model_id = openfga_authorization_model.test.id
+
+	user     = "user:user-1"
+	relation = "viewer"
+	type     = "document"
+
+	max_results = 2
+	timeout     = "30s"
+}
+
+data "openfga_list_objects_query" "with_all_results" {
+	depends_on = [openfga_relationship_tuple.test]
+
+	store_id = openfga_store.test.id
+	authorization_model_id = openfga_authorization_model.test.id
+
+	user     = "user:user-1"
+	relation = "viewer"
+	type     = "document"
+
+	max_results = 3
 }
 
 data "openfga_list_objects_query" "with_results" {
@@ -1,6 +1,9 @@
 package query
 
 import (
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/openfga/terraform-provider-openfga/internal/provider/relationshiptuple"
@@ -43,3 +46,22 @@ func NewListObjectsQueryModel(user string, relation string, type_ string, contex
 		ContextModel:     *relationshiptuple.NewContextModel(context),
 	}
 }
+
+type ListObjectsResultModel struct {
+	Result    types.Set  `tfsdk:"result"`
+	Truncated types.Bool `tfsdk:"truncated"`
+}
+
+func NewListObjectsResultModel(objects []string, truncated bool) *ListObjectsResultModel {
+	sort.Strings(objects)
+
+	elements := []attr.Value{}
+	for _, object := range objects {
+		elements = append(elements, types.StringValue(object))
+	}
+
+	return &ListObjectsResultModel{
+		Result:    types.SetValueMust(types.StringType, elements),
+		Truncated: types.BoolValue(truncated),
+	}
+}
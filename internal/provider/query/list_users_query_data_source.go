@@ -5,16 +5,23 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
-	"github.com/openfga/go-sdk/client"
+	"github.com/openfga/terraform-provider-openfga/internal/provider/providerdata"
+	"github.com/openfga/terraform-provider-openfga/internal/provider/relationshiptuple"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ datasource.DataSource = &ListUsersQueryDataSource{}
 var _ datasource.DataSourceWithConfigure = &ListUsersQueryDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &ListUsersQueryDataSource{}
 
 func NewListUsersQueryDataSource() datasource.DataSource {
 	return &ListUsersQueryDataSource{}
@@ -29,8 +36,9 @@ type ListUsersQueryDataSourceModel struct {
 	AuthorizationModelId types.String `tfsdk:"authorization_model_id"`
 
 	ListUsersQueryModel
+	relationshiptuple.ConsistencyModel
 
-	Result types.List `tfsdk:"result"`
+	ListUsersResultModel
 }
 
 func (d *ListUsersQueryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -51,8 +59,27 @@ func (d *ListUsersQueryDataSource) Schema(ctx context.Context, req datasource.Sc
 				Optional:            true,
 			},
 			"type": schema.StringAttribute{
-				MarkdownDescription: "The user type of the query",
-				Required:            true,
+				MarkdownDescription: "The user type of the query. Shorthand for a single entry of `user_filters` without a relation",
+				Optional:            true,
+			},
+			"user_filters": schema.ListNestedAttribute{
+				MarkdownDescription: "The kinds of users to return. A filter without a relation returns objects and wildcards of the type, a filter with a relation returns usersets, e.g. `group:eng#member`",
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The user type of the filter",
+							Required:            true,
+						},
+						"relation": schema.StringAttribute{
+							MarkdownDescription: "The relation of the usersets to return",
+							Optional:            true,
+						},
+					},
+				},
 			},
 			"relation": schema.StringAttribute{
 				MarkdownDescription: "The relation to check for",
@@ -102,33 +129,73 @@ func (d *ListUsersQueryDataSource) Schema(ctx context.Context, req datasource.Sc
 				CustomType:          jsontypes.NormalizedType{},
 				Optional:            true,
 			},
+			"consistency": schema.StringAttribute{
+				MarkdownDescription: "The consistency preference of the query, either `MINIMIZE_LATENCY` or `HIGHER_CONSISTENCY`. Defaults to the consistency preference of the provider",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(relationshiptuple.ConsistencyValues...),
+				},
+			},
 			"result": schema.ListAttribute{
-				MarkdownDescription: "A list of users the object is related with",
+				MarkdownDescription: "A list of users the object is related with, e.g. `user:anne`, `group:eng#member` or `user:*`",
 				ElementType:         types.StringType,
 				Computed:            true,
 			},
+			"users": schema.ListNestedAttribute{
+				MarkdownDescription: "The users the object is related with, in the same order as `result`",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the user",
+							Computed:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the user. Null for wildcards",
+							Computed:            true,
+						},
+						"relation": schema.StringAttribute{
+							MarkdownDescription: "The relation of a userset. Null for other users",
+							Computed:            true,
+						},
+						"wildcard": schema.BoolAttribute{
+							MarkdownDescription: "Whether the user is a typed wildcard, i.e. all users of the type",
+							Computed:            true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+func (d ListUsersQueryDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("type"),
+			path.MatchRoot("user_filters"),
+		),
+	}
+}
+
 func (d *ListUsersQueryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.OpenFgaClient)
+	providerData, ok := req.ProviderData.(*providerdata.ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.OpenFgaClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	d.client = NewQueryClient(client)
+	d.client = NewQueryClient(providerData.Client, providerData.Consistency, providerData.QueryCache)
 }
 
 func (d *ListUsersQueryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -140,13 +207,13 @@ func (d *ListUsersQueryDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
-	result, err := d.client.ListUsers(ctx, state.StoreId.ValueString(), state.AuthorizationModelId.ValueString(), state.ListUsersQueryModel)
+	result, err := d.client.ListUsers(ctx, state.StoreId.ValueString(), state.AuthorizationModelId.ValueString(), state.ListUsersQueryModel, state.Consistency.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to perform list users query, got error: %s", err))
 		return
 	}
 
-	state.Result = result
+	state.ListUsersResultModel = *result
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
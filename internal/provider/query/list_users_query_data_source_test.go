@@ -2,6 +2,7 @@ package query_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -28,6 +29,44 @@ func TestAccListUsersDataSource(t *testing.T) {
 							knownvalue.StringExact("user:user-1"),
 						}),
 					),
+					statecheck.ExpectKnownValue(
+						"data.openfga_list_users_query.with_results",
+						tfjsonpath.New("users"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"type":     knownvalue.StringExact("user"),
+								"id":       knownvalue.StringExact("user-1"),
+								"relation": knownvalue.Null(),
+								"wildcard": knownvalue.Bool(false),
+							}),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"data.openfga_list_users_query.with_user_filters",
+						tfjsonpath.New("result"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.StringExact("group:group-1#member"),
+							knownvalue.StringExact("user:*"),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"data.openfga_list_users_query.with_user_filters",
+						tfjsonpath.New("users"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"type":     knownvalue.StringExact("group"),
+								"id":       knownvalue.StringExact("group-1"),
+								"relation": knownvalue.StringExact("member"),
+								"wildcard": knownvalue.Bool(false),
+							}),
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"type":     knownvalue.StringExact("user"),
+								"id":       knownvalue.Null(),
+								"relation": knownvalue.Null(),
+								"wildcard": knownvalue.Bool(true),
+							}),
+						}),
+					),
 					statecheck.ExpectKnownValue(
 						"data.openfga_list_users_query.without_results",
 						tfjsonpath.New("result"),
@@ -58,6 +97,34 @@ func TestAccListUsersDataSource(t *testing.T) {
 	})
 }
 
+func TestAccListUsersDataSourceEmptyUserFilters(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Empty user filters testing
+			{
+				Config:      testAccListUsersDataSourceEmptyUserFiltersConfig(),
+				ExpectError: regexp.MustCompile(`Attribute user_filters list must contain at least 1 elements`),
+			},
+		},
+	})
+}
+
+func testAccListUsersDataSourceEmptyUserFiltersConfig() string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "openfga_list_users_query" "test" {
+	store_id = "01ARZ3NDEKTSV4RRFFQ69G5FAV"
+
+	user_filters = []
+	relation     = "viewer"
+	object       = "document:document-1"
+}
+`, acceptance.ProviderConfig)
+}
+
 func testAccListUsersDataSourceConfig() string {
 	return fmt.Sprintf(`
 %[1]s
@@ -73,9 +140,13 @@ model
 
 type user
 
+type group
+	relations
+		define member: [user]
+
 type document
 	relations
-		define viewer: [user, user with larger_than]
+		define viewer: [user, user:*, group#member, user with larger_than]
 
 condition larger_than(required: int, provided: int) {
 	provided > required
@@ -98,6 +169,41 @@ resource "openfga_relationship_tuple" "test" {
 	object    = "document:document-1"
 }
 
+resource "openfga_relationship_tuple" "wildcard" {
+	store_id               = openfga_store.test.id
+	authorization_model_id = openfga_authorization_model.test.id
+
+	user      = "user:*"
+	relation  = "viewer"
+	object    = "document:document-3"
+}
+
+resource "openfga_relationship_tuple" "userset" {
+	store_id               = openfga_store.test.id
+	authorization_model_id = openfga_authorization_model.test.id
+
+	user      = "group:group-1#member"
+	relation  = "viewer"
+	object    = "document:document-3"
+}
+
+data "openfga_list_users_query" "with_user_filters" {
+	depends_on = [
+		openfga_relationship_tuple.wildcard,
+		openfga_relationship_tuple.userset,
+	]
+
+	store_id = openfga_store.test.id
+	authorization_model_id = openfga_authorization_model.test.id
+
+	user_filters = [
+		{ type = "user" },
+		{ type = "group", relation = "member" },
+	]
+	relation = "viewer"
+	object   = "document:document-3"
+}
+
 data "openfga_list_users_query" "with_results" {
 	depends_on = [openfga_relationship_tuple.test]
 
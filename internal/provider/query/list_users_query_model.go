@@ -1,21 +1,81 @@
 package query
 
 import (
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	openfga "github.com/openfga/go-sdk"
 
 	"github.com/openfga/terraform-provider-openfga/internal/provider/relationshiptuple"
 )
 
+type UserFilterModel struct {
+	Type     types.String `tfsdk:"type"`
+	Relation types.String `tfsdk:"relation"`
+}
+
+func (filter UserFilterModel) GetType() string {
+	return filter.Type.ValueString()
+}
+
+func (filter UserFilterModel) GetRelation() string {
+	return filter.Relation.ValueString()
+}
+
+// String formats the user filter like a user of the filtered kind, e.g.
+// `user` or `group#member`.
+func (filter UserFilterModel) String() string {
+	if filter.GetRelation() == "" {
+		return filter.GetType()
+	}
+
+	return filter.GetType() + "#" + filter.GetRelation()
+}
+
+func (filter UserFilterModel) ToUserTypeFilter() openfga.UserTypeFilter {
+	userTypeFilter := openfga.UserTypeFilter{Type: filter.GetType()}
+	if filter.GetRelation() != "" {
+		userTypeFilter.Relation = openfga.PtrString(filter.GetRelation())
+	}
+
+	return userTypeFilter
+}
+
+func NewUserFilterModel(type_ string, relation string) *UserFilterModel {
+	relationValue := types.StringNull()
+	if relation != "" {
+		relationValue = types.StringValue(relation)
+	}
+
+	return &UserFilterModel{
+		Type:     types.StringValue(type_),
+		Relation: relationValue,
+	}
+}
+
 type ListUsersQueryModel struct {
 	Type             types.String                                             `tfsdk:"type"`
+	UserFilters      *[]UserFilterModel                                       `tfsdk:"user_filters"`
 	Relation         types.String                                             `tfsdk:"relation"`
 	Object           types.String                                             `tfsdk:"object"`
 	ContextualTuples *[]relationshiptuple.RelationshipTupleWithConditionModel `tfsdk:"contextual_tuples"`
 	relationshiptuple.ContextModel
 }
 
-func (query ListUsersQueryModel) GetType() string {
-	return query.Type.ValueString()
+// GetUserFilters returns the user filters of the query, including the filter
+// given by the `type` shorthand.
+func (query ListUsersQueryModel) GetUserFilters() []UserFilterModel {
+	userFilters := []UserFilterModel{}
+	if !query.Type.IsNull() {
+		userFilters = append(userFilters, *NewUserFilterModel(query.Type.ValueString(), ""))
+	}
+
+	if query.UserFilters != nil {
+		userFilters = append(userFilters, *query.UserFilters...)
+	}
+
+	return userFilters
 }
 
 func (query ListUsersQueryModel) GetRelation() string {
@@ -34,12 +94,95 @@ func (query ListUsersQueryModel) GetContextualTuples() []relationshiptuple.Relat
 	return *query.ContextualTuples
 }
 
-func NewListUsersQueryModel(type_ string, relation string, object string, contextualTuples *[]relationshiptuple.RelationshipTupleWithConditionModel, context *map[string]interface{}) *ListUsersQueryModel {
+func NewListUsersQueryModel(userFilters []UserFilterModel, relation string, object string, contextualTuples *[]relationshiptuple.RelationshipTupleWithConditionModel, context *map[string]interface{}) *ListUsersQueryModel {
 	return &ListUsersQueryModel{
-		Type:             types.StringValue(type_),
+		Type:             types.StringNull(),
+		UserFilters:      &userFilters,
 		Relation:         types.StringValue(relation),
 		Object:           types.StringValue(object),
 		ContextualTuples: contextualTuples,
 		ContextModel:     *relationshiptuple.NewContextModel(context),
 	}
 }
+
+// ListUsersUserModel is a single result of a list users query, which is either
+// an object, a userset or a typed wildcard.
+type ListUsersUserModel struct {
+	Type     types.String `tfsdk:"type"`
+	Id       types.String `tfsdk:"id"`
+	Relation types.String `tfsdk:"relation"`
+	Wildcard types.Bool   `tfsdk:"wildcard"`
+}
+
+// String formats the user like the user of a relationship tuple, e.g.
+// `user:anne`, `group:eng#member` or `user:*`.
+func (model ListUsersUserModel) String() string {
+	if model.Wildcard.ValueBool() {
+		return model.Type.ValueString() + ":*"
+	}
+
+	if model.Relation.IsNull() {
+		return model.Type.ValueString() + ":" + model.Id.ValueString()
+	}
+
+	return model.Type.ValueString() + ":" + model.Id.ValueString() + "#" + model.Relation.ValueString()
+}
+
+func NewListUsersUserModel(user openfga.User) *ListUsersUserModel {
+	model := ListUsersUserModel{
+		Type:     types.StringNull(),
+		Id:       types.StringNull(),
+		Relation: types.StringNull(),
+		Wildcard: types.BoolValue(false),
+	}
+
+	switch {
+	case user.Object != nil:
+		model.Type = types.StringValue(user.Object.Type)
+		model.Id = types.StringValue(user.Object.Id)
+	case user.Userset != nil:
+		model.Type = types.StringValue(user.Userset.Type)
+		model.Id = types.StringValue(user.Userset.Id)
+		model.Relation = types.StringValue(user.Userset.Relation)
+	case user.Wildcard != nil:
+		model.Type = types.StringValue(user.Wildcard.Type)
+		model.Wildcard = types.BoolValue(true)
+	}
+
+	return &model
+}
+
+type ListUsersResultModel struct {
+	Result types.List           `tfsdk:"result"`
+	Users  []ListUsersUserModel `tfsdk:"users"`
+}
+
+// NewListUsersResultModel creates the result of a list users query. The users
+// are deduplicated and sorted, as the order of the responses is not stable.
+func NewListUsersResultModel(users []openfga.User) *ListUsersResultModel {
+	userModels := []ListUsersUserModel{}
+	seen := map[string]bool{}
+	for _, user := range users {
+		userModel := NewListUsersUserModel(user)
+		if seen[userModel.String()] {
+			continue
+		}
+
+		seen[userModel.String()] = true
+		userModels = append(userModels, *userModel)
+	}
+
+	sort.SliceStable(userModels, func(i, j int) bool {
+		return userModels[i].String() < userModels[j].String()
+	})
+
+	elements := []attr.Value{}
+	for _, userModel := range userModels {
+		elements = append(elements, types.StringValue(userModel.String()))
+	}
+
+	return &ListUsersResultModel{
+		Result: types.ListValueMust(types.StringType, elements),
+		Users:  userModels,
+	}
+}
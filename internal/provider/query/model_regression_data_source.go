@@ -0,0 +1,338 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/openfga/terraform-provider-openfga/internal/provider/providerdata"
+	"github.com/openfga/terraform-provider-openfga/internal/provider/relationshiptuple"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ModelRegressionDataSource{}
+var _ datasource.DataSourceWithConfigure = &ModelRegressionDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &ModelRegressionDataSource{}
+
+func NewModelRegressionDataSource() datasource.DataSource {
+	return &ModelRegressionDataSource{}
+}
+
+type ModelRegressionDataSource struct {
+	client                  *QueryClient
+	relationshipTupleClient *relationshiptuple.RelationshipTupleClient
+}
+
+type ModelRegressionDataSourceModel struct {
+	StoreId                       types.String `tfsdk:"store_id"`
+	BaseAuthorizationModelId      types.String `tfsdk:"base_authorization_model_id"`
+	CandidateAuthorizationModelId types.String `tfsdk:"candidate_authorization_model_id"`
+
+	Queries          *[]CheckQueryModel          `tfsdk:"queries"`
+	Sample           *ModelRegressionSampleModel `tfsdk:"sample"`
+	FailOnDifference types.Bool                  `tfsdk:"fail_on_difference"`
+
+	MaxBatchSize        types.Int64 `tfsdk:"max_batch_size"`
+	MaxParallelRequests types.Int64 `tfsdk:"max_parallel_requests"`
+
+	QueryCount        types.Int64            `tfsdk:"query_count"`
+	SkippedTupleCount types.Int64            `tfsdk:"skipped_tuple_count"`
+	Differences       []CheckComparisonModel `tfsdk:"differences"`
+}
+
+func (model ModelRegressionDataSourceModel) GetMaxBatchSize() int32 {
+	if model.MaxBatchSize.IsNull() {
+		return defaultBatchCheckMaxBatchSize
+	}
+
+	return int32(model.MaxBatchSize.ValueInt64())
+}
+
+func (model ModelRegressionDataSourceModel) GetMaxParallelRequests() int32 {
+	if model.MaxParallelRequests.IsNull() {
+		return defaultBatchCheckMaxParallelRequests
+	}
+
+	return int32(model.MaxParallelRequests.ValueInt64())
+}
+
+func (d *ModelRegressionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_model_regression"
+}
+
+func (d *ModelRegressionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+A model regression runs a set of 'check' queries against two authorization models of the same store and reports every query whose result differs. The queries are sent as 'batch check' requests.
+
+The queries can be provided explicitly or generated from relationship tuples sampled from the store. This allows to verify that a new authorization model does not change known access before it is used.
+`,
+
+		Attributes: map[string]schema.Attribute{
+			"store_id": schema.StringAttribute{
+				MarkdownDescription: "The unique ID of the OpenFGA store the queries are run against",
+				Required:            true,
+			},
+			"base_authorization_model_id": schema.StringAttribute{
+				MarkdownDescription: "The unique ID of the authorization model the results are compared to, e.g. the currently used model",
+				Required:            true,
+			},
+			"candidate_authorization_model_id": schema.StringAttribute{
+				MarkdownDescription: "The unique ID of the authorization model to verify, e.g. a newly published model",
+				Required:            true,
+			},
+			"queries": schema.ListNestedAttribute{
+				MarkdownDescription: "Explicit check queries to run against both authorization models",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"user": schema.StringAttribute{
+							MarkdownDescription: "The user of the query",
+							Required:            true,
+						},
+						"relation": schema.StringAttribute{
+							MarkdownDescription: "The relation to check for",
+							Required:            true,
+						},
+						"object": schema.StringAttribute{
+							MarkdownDescription: "The object of the query",
+							Required:            true,
+						},
+						"contextual_tuples": schema.ListNestedAttribute{
+							MarkdownDescription: "The contextual tuples that should be considered for the query",
+							Optional:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"user": schema.StringAttribute{
+										MarkdownDescription: "The user of the contextual relationship tuple",
+										Required:            true,
+									},
+									"relation": schema.StringAttribute{
+										MarkdownDescription: "The relation of the contextual relationship tuple",
+										Required:            true,
+									},
+									"object": schema.StringAttribute{
+										MarkdownDescription: "The object of the contextual relationship tuple",
+										Required:            true,
+									},
+									"condition": schema.SingleNestedAttribute{
+										MarkdownDescription: "A condition of the contextual relationship tuple",
+										Optional:            true,
+										Attributes: map[string]schema.Attribute{
+											"name": schema.StringAttribute{
+												MarkdownDescription: "The name of the condition",
+												Required:            true,
+											},
+											"context_json": schema.StringAttribute{
+												MarkdownDescription: "The (partial) context under which the condition is evaluated",
+												CustomType:          jsontypes.NormalizedType{},
+												Optional:            true,
+											},
+										},
+									},
+								},
+							},
+						},
+						"context_json": schema.StringAttribute{
+							MarkdownDescription: "The (partial) context under which the condition is evaluated",
+							CustomType:          jsontypes.NormalizedType{},
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"sample": schema.SingleNestedAttribute{
+				MarkdownDescription: "Generates check queries from the relationship tuples of the store. For every sampled tuple, the relation of the tuple and all listed `relations` are checked for the user and object of the tuple. Sampled tuples with a condition are skipped, since no context is available to evaluate it",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"max_tuples": schema.Int64Attribute{
+						MarkdownDescription: "The maximum number of relationship tuples to sample. Defaults to `100`",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"relations": schema.ListAttribute{
+						MarkdownDescription: "Additional relations to check for the user and object of every sampled tuple",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+				},
+			},
+			"max_batch_size": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of checks sent in a single BatchCheck request, between `1` and `50`, the default limit of the OpenFGA server. Defaults to `50`",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 50),
+				},
+			},
+			"max_parallel_requests": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of BatchCheck requests sent concurrently per authorization model. Defaults to `10`",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"fail_on_difference": schema.BoolAttribute{
+				MarkdownDescription: "Whether to raise an error if the results of any query differ",
+				Optional:            true,
+			},
+			"query_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of queries run against both authorization models",
+				Computed:            true,
+			},
+			"skipped_tuple_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of sampled relationship tuples skipped because they have a condition",
+				Computed:            true,
+			},
+			"differences": schema.ListNestedAttribute{
+				MarkdownDescription: "The queries whose results or errors differ between both authorization models",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"user": schema.StringAttribute{
+							MarkdownDescription: "The user of the query",
+							Computed:            true,
+						},
+						"relation": schema.StringAttribute{
+							MarkdownDescription: "The relation of the query",
+							Computed:            true,
+						},
+						"object": schema.StringAttribute{
+							MarkdownDescription: "The object of the query",
+							Computed:            true,
+						},
+						"base_result": schema.BoolAttribute{
+							MarkdownDescription: "The result of the query against the base authorization model",
+							Computed:            true,
+						},
+						"base_error": schema.StringAttribute{
+							MarkdownDescription: "The error of the query against the base authorization model",
+							Computed:            true,
+						},
+						"candidate_result": schema.BoolAttribute{
+							MarkdownDescription: "The result of the query against the candidate authorization model",
+							Computed:            true,
+						},
+						"candidate_error": schema.StringAttribute{
+							MarkdownDescription: "The error of the query against the candidate authorization model",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d ModelRegressionDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.AtLeastOneOf(
+			path.MatchRoot("queries"),
+			path.MatchRoot("sample"),
+		),
+	}
+}
+
+func (d *ModelRegressionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providerdata.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = NewQueryClient(providerData.Client, providerData.Consistency, providerData.QueryCache)
+	d.relationshipTupleClient = relationshiptuple.NewRelationshipTupleClient(providerData.Client, providerData.Consistency)
+}
+
+func (d *ModelRegressionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ModelRegressionDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	storeId := state.StoreId.ValueString()
+
+	queries := []CheckQueryModel{}
+	if state.Queries != nil {
+		queries = append(queries, *state.Queries...)
+	}
+
+	skipped := 0
+	if state.Sample != nil {
+		tuples, _, err := d.relationshipTupleClient.ListRelationshipTuplesPage(ctx, storeId, nil, "", relationshiptuple.ScanOptions{
+			MaxResults: state.Sample.GetMaxTuples(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to sample relationship tuples, got error: %s", err))
+			return
+		}
+
+		var sampled []CheckQueryModel
+		sampled, skipped = state.Sample.ToCheckQueryModels(*tuples)
+		queries = append(queries, sampled...)
+	}
+
+	comparisons, err := d.client.CompareCheck(ctx, storeId, state.BaseAuthorizationModelId.ValueString(), state.CandidateAuthorizationModelId.ValueString(), queries, state.GetMaxBatchSize(), state.GetMaxParallelRequests())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to run check queries, got error: %s", err))
+		return
+	}
+
+	state.Differences = []CheckComparisonModel{}
+	for _, comparison := range comparisons {
+		if comparison.IsDifferent() {
+			state.Differences = append(state.Differences, comparison)
+		}
+	}
+
+	state.QueryCount = types.Int64Value(int64(len(queries)))
+	state.SkippedTupleCount = types.Int64Value(int64(skipped))
+
+	if state.FailOnDifference.ValueBool() {
+		for _, difference := range state.Differences {
+			resp.Diagnostics.AddError(
+				"Model Regression",
+				fmt.Sprintf(
+					"Check query (user=%s, relation=%s, object=%s) differs between the authorization models: %s (base) vs. %s (candidate)",
+					difference.GetUser(),
+					difference.GetRelation(),
+					difference.GetObject(),
+					formatComparisonOutcome(difference.BaseResult, difference.BaseError),
+					formatComparisonOutcome(difference.CandidateResult, difference.CandidateError),
+				),
+			)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func formatComparisonOutcome(result types.Bool, err types.String) string {
+	if !err.IsNull() {
+		return fmt.Sprintf("error %q", err.ValueString())
+	}
+
+	return fmt.Sprintf("%t", result.ValueBool())
+}
@@ -0,0 +1,173 @@
+package query_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/openfga/terraform-provider-openfga/internal/provider/acceptance"
+)
+
+func TestAccModelRegressionDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccModelRegressionDataSourceConfig(false, `{ relations = ["editor"] }`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.openfga_model_regression.test",
+						tfjsonpath.New("query_count"),
+						knownvalue.Int64Exact(3),
+					),
+					statecheck.ExpectKnownValue(
+						"data.openfga_model_regression.test",
+						tfjsonpath.New("skipped_tuple_count"),
+						knownvalue.Int64Exact(1),
+					),
+					statecheck.ExpectKnownValue(
+						"data.openfga_model_regression.test",
+						tfjsonpath.New("differences"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"user":             knownvalue.StringExact("user:user-1"),
+								"relation":         knownvalue.StringExact("viewer"),
+								"object":           knownvalue.StringExact("document:document-1"),
+								"base_result":      knownvalue.Bool(true),
+								"base_error":       knownvalue.Null(),
+								"candidate_result": knownvalue.Bool(false),
+								"candidate_error":  knownvalue.Null(),
+							}),
+						}),
+					),
+				},
+			},
+			// Sample larger than a single page testing
+			{
+				Config: testAccModelRegressionDataSourceConfig(false, `{ relations = ["editor"], max_tuples = 250 }`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.openfga_model_regression.test",
+						tfjsonpath.New("query_count"),
+						knownvalue.Int64Exact(3),
+					),
+				},
+			},
+			// Fail on difference testing
+			{
+				Config:      testAccModelRegressionDataSourceConfig(true, `{ relations = ["editor"] }`),
+				ExpectError: regexp.MustCompile("Model Regression"),
+			},
+		},
+	})
+}
+
+func testAccModelRegressionDataSourceConfig(failOnDifference bool, sample string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "openfga_store" "test" {
+	name = "test"
+}
+
+data "openfga_authorization_model_document" "base" {
+	dsl = <<EOT
+model
+	schema 1.1
+
+type user
+
+type document
+	relations
+		define editor: [user, user with in_region]
+		define viewer: [user]
+
+condition in_region(region: string) {
+	region == "eu"
+}
+	EOT
+}
+
+resource "openfga_authorization_model" "base" {
+	store_id = openfga_store.test.id
+
+	model_json = data.openfga_authorization_model_document.base.result
+}
+
+data "openfga_authorization_model_document" "candidate" {
+	dsl = <<EOT
+model
+	schema 1.1
+
+type user
+
+type document
+	relations
+		define editor: [user, user with in_region]
+		define viewer: [user] and editor
+
+condition in_region(region: string) {
+	region == "eu"
+}
+	EOT
+}
+
+resource "openfga_authorization_model" "candidate" {
+	store_id = openfga_store.test.id
+
+	model_json = data.openfga_authorization_model_document.candidate.result
+}
+
+resource "openfga_relationship_tuple" "test" {
+	store_id               = openfga_store.test.id
+	authorization_model_id = openfga_authorization_model.base.id
+
+	user     = "user:user-1"
+	relation = "viewer"
+	object   = "document:document-1"
+}
+
+resource "openfga_relationship_tuple" "conditional" {
+	store_id               = openfga_store.test.id
+	authorization_model_id = openfga_authorization_model.base.id
+
+	user     = "user:user-3"
+	relation = "editor"
+	object   = "document:document-1"
+
+	condition = {
+		name         = "in_region"
+		context_json = jsonencode({
+			region = "eu"
+		})
+	}
+}
+
+data "openfga_model_regression" "test" {
+	store_id                         = openfga_store.test.id
+	base_authorization_model_id      = openfga_authorization_model.base.id
+	candidate_authorization_model_id = openfga_authorization_model.candidate.id
+
+	queries = [{
+		user     = "user:user-2"
+		relation = "viewer"
+		object   = "document:document-1"
+	}]
+
+	sample = %[3]s
+
+	max_batch_size = 2
+
+	fail_on_difference = %[2]t
+
+	depends_on = [openfga_relationship_tuple.test, openfga_relationship_tuple.conditional]
+}
+`, acceptance.ProviderConfig, failOnDifference, sample)
+}
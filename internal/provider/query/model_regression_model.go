@@ -0,0 +1,78 @@
+package query
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/openfga/terraform-provider-openfga/internal/provider/relationshiptuple"
+)
+
+type CheckComparisonModel struct {
+	relationshiptuple.RelationshipTupleModel
+	BaseResult      types.Bool   `tfsdk:"base_result"`
+	BaseError       types.String `tfsdk:"base_error"`
+	CandidateResult types.Bool   `tfsdk:"candidate_result"`
+	CandidateError  types.String `tfsdk:"candidate_error"`
+}
+
+// IsDifferent reports whether the check query resulted in a different
+// outcome for both authorization models, where an error counts as outcome.
+// Different errors are different outcomes as well.
+func (model CheckComparisonModel) IsDifferent() bool {
+	if !model.BaseError.Equal(model.CandidateError) {
+		return true
+	}
+
+	return !model.BaseResult.Equal(model.CandidateResult)
+}
+
+type ModelRegressionSampleModel struct {
+	MaxTuples types.Int64    `tfsdk:"max_tuples"`
+	Relations []types.String `tfsdk:"relations"`
+}
+
+func (model ModelRegressionSampleModel) GetMaxTuples() int {
+	if model.MaxTuples.IsNull() || model.MaxTuples.IsUnknown() {
+		return 100
+	}
+
+	return int(model.MaxTuples.ValueInt64())
+}
+
+// ToCheckQueryModels generates check queries from the given relationship
+// tuples. For every tuple, the relation of the tuple as well as all
+// configured relations are checked for the user and object of the tuple.
+// Tuples with a condition are skipped, as their checks would depend on a
+// context the sample does not provide, and their number is returned as well.
+func (model ModelRegressionSampleModel) ToCheckQueryModels(tuples []relationshiptuple.RelationshipTupleWithConditionModel) ([]CheckQueryModel, int) {
+	queries := []CheckQueryModel{}
+	seen := map[string]bool{}
+	skipped := 0
+
+	for index, tuple := range tuples {
+		if index >= model.GetMaxTuples() {
+			break
+		}
+
+		if tuple.GetCondition() != nil {
+			skipped++
+			continue
+		}
+
+		relations := []string{tuple.GetRelation()}
+		for _, relation := range model.Relations {
+			relations = append(relations, relation.ValueString())
+		}
+
+		for _, relation := range relations {
+			key := tuple.GetUser() + "|" + relation + "|" + tuple.GetObject()
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			queries = append(queries, *NewCheckQueryModel(tuple.GetUser(), relation, tuple.GetObject(), nil, nil))
+		}
+	}
+
+	return queries, skipped
+}
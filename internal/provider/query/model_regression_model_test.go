@@ -0,0 +1,101 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/openfga/terraform-provider-openfga/internal/provider/relationshiptuple"
+)
+
+func TestCheckComparisonModelIsDifferent(t *testing.T) {
+	testCases := map[string]struct {
+		comparison CheckComparisonModel
+		expected   bool
+	}{
+		"same results": {
+			comparison: CheckComparisonModel{
+				BaseResult:      types.BoolValue(true),
+				BaseError:       types.StringNull(),
+				CandidateResult: types.BoolValue(true),
+				CandidateError:  types.StringNull(),
+			},
+			expected: false,
+		},
+		"different results": {
+			comparison: CheckComparisonModel{
+				BaseResult:      types.BoolValue(true),
+				BaseError:       types.StringNull(),
+				CandidateResult: types.BoolValue(false),
+				CandidateError:  types.StringNull(),
+			},
+			expected: true,
+		},
+		"error of a single model": {
+			comparison: CheckComparisonModel{
+				BaseResult:      types.BoolValue(false),
+				BaseError:       types.StringNull(),
+				CandidateResult: types.BoolNull(),
+				CandidateError:  types.StringValue("type 'folder' not found"),
+			},
+			expected: true,
+		},
+		"same errors": {
+			comparison: CheckComparisonModel{
+				BaseResult:      types.BoolNull(),
+				BaseError:       types.StringValue("type 'folder' not found"),
+				CandidateResult: types.BoolNull(),
+				CandidateError:  types.StringValue("type 'folder' not found"),
+			},
+			expected: false,
+		},
+		"different errors": {
+			comparison: CheckComparisonModel{
+				BaseResult:      types.BoolNull(),
+				BaseError:       types.StringValue("type 'folder' not found"),
+				CandidateResult: types.BoolNull(),
+				CandidateError:  types.StringValue("relation 'folder#viewer' not found"),
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if actual := testCase.comparison.IsDifferent(); actual != testCase.expected {
+				t.Fatalf("expected %t, got %t", testCase.expected, actual)
+			}
+		})
+	}
+}
+
+func TestModelRegressionSampleModelToCheckQueryModels(t *testing.T) {
+	sample := ModelRegressionSampleModel{
+		MaxTuples: types.Int64Value(3),
+		Relations: []types.String{types.StringValue("editor")},
+	}
+
+	tuples := []relationshiptuple.RelationshipTupleWithConditionModel{
+		*relationshiptuple.NewRelationshipTupleWithConditionModel("user:anne", "viewer", "document:1", nil),
+		*relationshiptuple.NewRelationshipTupleWithConditionModel("user:bob", "viewer", "document:1", relationshiptuple.NewRelationshipConditionModel("in_region", nil)),
+		*relationshiptuple.NewRelationshipTupleWithConditionModel("user:anne", "editor", "document:1", nil),
+		*relationshiptuple.NewRelationshipTupleWithConditionModel("user:carol", "viewer", "document:1", nil),
+	}
+
+	queries, skipped := sample.ToCheckQueryModels(tuples)
+
+	if skipped != 1 {
+		t.Fatalf("expected 1 skipped tuple, got %d", skipped)
+	}
+
+	expected := []string{"user:anne viewer document:1", "user:anne editor document:1"}
+	if len(queries) != len(expected) {
+		t.Fatalf("expected %d queries, got %d", len(expected), len(queries))
+	}
+
+	for index, query := range queries {
+		if actual := query.GetUser() + " " + query.GetRelation() + " " + query.GetObject(); actual != expected[index] {
+			t.Fatalf("expected query %q, got %q", expected[index], actual)
+		}
+	}
+}
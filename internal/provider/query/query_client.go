@@ -2,21 +2,32 @@ package query
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
-	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	openfga "github.com/openfga/go-sdk"
 	"github.com/openfga/go-sdk/client"
+
+	"github.com/openfga/terraform-provider-openfga/internal/provider/relationshiptuple"
+	"github.com/openfga/terraform-provider-openfga/internal/querycache"
 )
 
 type QueryClient struct {
-	client *client.OpenFgaClient
+	client      *client.OpenFgaClient
+	consistency openfga.ConsistencyPreference
+
+	// cache holds the results of Check, ListObjects, ListUsers and BatchCheck
+	// queries. It is nil if results should not be cached.
+	cache *querycache.Cache
 }
 
-func NewQueryClient(client *client.OpenFgaClient) *QueryClient {
-	return &QueryClient{client: client}
+func NewQueryClient(client *client.OpenFgaClient, consistency openfga.ConsistencyPreference, cache *querycache.Cache) *QueryClient {
+	return &QueryClient{client: client, consistency: consistency, cache: cache}
 }
 
 func (query CheckQueryModel) ToCheckRequest() (*client.ClientCheckRequest, error) {
@@ -44,10 +55,11 @@ func (query CheckQueryModel) ToCheckRequest() (*client.ClientCheckRequest, error
 	}, nil
 }
 
-func (wrapper *QueryClient) Check(ctx context.Context, storeId string, authorizationModelId string, model CheckQueryModel) (types.Bool, error) {
+func (wrapper *QueryClient) Check(ctx context.Context, storeId string, authorizationModelId string, model CheckQueryModel, consistency string) (types.Bool, error) {
 	options := client.ClientCheckOptions{
 		StoreId:              openfga.PtrString(storeId),
 		AuthorizationModelId: openfga.PtrString(authorizationModelId),
+		Consistency:          relationshiptuple.ResolveConsistency(consistency, wrapper.consistency),
 	}
 
 	body, err := model.ToCheckRequest()
@@ -55,15 +67,27 @@ func (wrapper *QueryClient) Check(ctx context.Context, storeId string, authoriza
 		return types.BoolNull(), err
 	}
 
-	response, err := wrapper.client.Check(ctx).Options(options).Body(*body).Execute()
+	key, err := querycache.Key("check", storeId, authorizationModelId, options.Consistency, body)
 	if err != nil {
 		return types.BoolNull(), err
 	}
 
-	return types.BoolValue(response.GetAllowed()), nil
+	allowed, err := wrapper.cache.Do(key, func() (interface{}, error) {
+		response, err := wrapper.client.Check(ctx).Options(options).Body(*body).Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		return response.GetAllowed(), nil
+	})
+	if err != nil {
+		return types.BoolNull(), err
+	}
+
+	return types.BoolValue(allowed.(bool)), nil
 }
 
-func (query ListObjectsQueryModel) ToListObjectsRequest() (*client.ClientListObjectsRequest, error) {
+func (query ListObjectsQueryModel) ToListObjectsRequest() (*client.ClientStreamedListObjectsRequest, error) {
 	context, err := query.GetContextMap()
 	if err != nil {
 		return nil, err
@@ -79,7 +103,7 @@ func (query ListObjectsQueryModel) ToListObjectsRequest() (*client.ClientListObj
 		contextualTuples = append(contextualTuples, *contextualTuple)
 	}
 
-	return &client.ClientListObjectsRequest{
+	return &client.ClientStreamedListObjectsRequest{
 		User:             query.GetUser(),
 		Relation:         query.GetRelation(),
 		Type:             query.GetType(),
@@ -88,28 +112,82 @@ func (query ListObjectsQueryModel) ToListObjectsRequest() (*client.ClientListObj
 	}, nil
 }
 
-func (wrapper *QueryClient) ListObjects(ctx context.Context, storeId string, authorizationModelId string, model ListObjectsQueryModel) (types.List, error) {
-	options := client.ClientListObjectsOptions{
+// ListObjects streams the objects of a list objects query, so that the result
+// is not capped by the limits of the server. The result is truncated once
+// maxResults objects were received or the timeout has passed. A maxResults or
+// timeout of zero disables the respective limit.
+func (wrapper *QueryClient) ListObjects(ctx context.Context, storeId string, authorizationModelId string, model ListObjectsQueryModel, consistency string, maxResults int, timeout time.Duration) (*ListObjectsResultModel, error) {
+	options := client.ClientStreamedListObjectsOptions{
 		StoreId:              openfga.PtrString(storeId),
 		AuthorizationModelId: openfga.PtrString(authorizationModelId),
+		Consistency:          relationshiptuple.ResolveConsistency(consistency, wrapper.consistency),
 	}
 
 	body, err := model.ToListObjectsRequest()
 	if err != nil {
-		return types.ListNull(types.StringType), err
+		return nil, err
+	}
+
+	key, err := querycache.Key("list_objects", storeId, authorizationModelId, options.Consistency, body, maxResults, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := wrapper.cache.Do(key, func() (interface{}, error) {
+		result, timedOut, err := wrapper.streamListObjects(ctx, options, *body, maxResults, timeout)
+		if timedOut {
+			// The objects received until the timeout depend on the server
+			return querycache.Uncached(result), err
+		}
+
+		return result, err
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	response, err := wrapper.client.ListObjects(ctx).Options(options).Body(*body).Execute()
+	return result.(*ListObjectsResultModel), nil
+}
+
+// streamListObjects returns the streamed objects, and whether the stream was
+// stopped by the timeout. The result is only truncated by maxResults if the
+// stream holds further objects.
+func (wrapper *QueryClient) streamListObjects(ctx context.Context, options client.ClientStreamedListObjectsOptions, body client.ClientStreamedListObjectsRequest, maxResults int, timeout time.Duration) (*ListObjectsResultModel, bool, error) {
+	streamCtx := ctx
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		streamCtx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	response, err := wrapper.client.StreamedListObjects(streamCtx).Options(options).Body(body).Execute()
 	if err != nil {
-		return types.ListNull(types.StringType), err
+		if errors.Is(err, context.DeadlineExceeded) {
+			return NewListObjectsResultModel([]string{}, true), true, nil
+		}
+
+		return nil, false, err
+	}
+	defer response.Close()
+
+	objects := []string{}
+	for object := range response.Objects {
+		if maxResults > 0 && len(objects) >= maxResults {
+			return NewListObjectsResultModel(objects, true), false, nil
+		}
+
+		objects = append(objects, object.GetObject())
 	}
 
-	elements := []attr.Value{}
-	for _, object := range response.GetObjects() {
-		elements = append(elements, types.StringValue(object))
+	if err := <-response.Errors; err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return NewListObjectsResultModel(objects, true), true, nil
+		}
+
+		return nil, false, err
 	}
 
-	return types.ListValueMust(types.StringType, elements), nil
+	return NewListObjectsResultModel(objects, false), false, nil
 }
 
 func (query ListUsersQueryModel) ToListUsersRequest() (*client.ClientListUsersRequest, error) {
@@ -134,9 +212,6 @@ func (query ListUsersQueryModel) ToListUsersRequest() (*client.ClientListUsersRe
 	}
 
 	return &client.ClientListUsersRequest{
-		UserFilters: []openfga.UserTypeFilter{
-			{Type: query.Type.ValueString()},
-		},
 		Relation:         query.GetRelation(),
 		Object:           openfga.FgaObject{Type: objectParts[0], Id: objectParts[1]},
 		ContextualTuples: contextualTuples,
@@ -144,26 +219,218 @@ func (query ListUsersQueryModel) ToListUsersRequest() (*client.ClientListUsersRe
 	}, nil
 }
 
-func (wrapper *QueryClient) ListUsers(ctx context.Context, storeId string, authorizationModelId string, model ListUsersQueryModel) (types.List, error) {
+func (wrapper *QueryClient) ListUsers(ctx context.Context, storeId string, authorizationModelId string, model ListUsersQueryModel, consistency string) (*ListUsersResultModel, error) {
 	options := client.ClientListUsersOptions{
 		StoreId:              openfga.PtrString(storeId),
 		AuthorizationModelId: openfga.PtrString(authorizationModelId),
+		Consistency:          relationshiptuple.ResolveConsistency(consistency, wrapper.consistency),
 	}
 
 	body, err := model.ToListUsersRequest()
 	if err != nil {
-		return types.ListNull(types.StringType), err
+		return nil, err
+	}
+
+	userFilters := model.GetUserFilters()
+
+	key, err := querycache.Key("list_users", storeId, authorizationModelId, options.Consistency, body, userFilters)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := wrapper.cache.Do(key, func() (interface{}, error) {
+		return wrapper.listUsers(ctx, options, *body, userFilters)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*ListUsersResultModel), nil
+}
+
+func (wrapper *QueryClient) listUsers(ctx context.Context, options client.ClientListUsersOptions, body client.ClientListUsersRequest, userFilters []UserFilterModel) (*ListUsersResultModel, error) {
+	// The API accepts a single user filter per request, so a request is sent
+	// for every filter and the results are merged.
+	users := []openfga.User{}
+	for _, userFilter := range userFilters {
+		body.UserFilters = []openfga.UserTypeFilter{userFilter.ToUserTypeFilter()}
+
+		response, err := wrapper.client.ListUsers(ctx).Options(options).Body(body).Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		users = append(users, response.GetUsers()...)
+	}
+
+	return NewListUsersResultModel(users), nil
+}
+
+func (query ExpandQueryModel) ToExpandRequest() (*client.ClientExpandRequest, error) {
+	contextualTuples := []client.ClientContextualTupleKey{}
+	for _, contextualTupleModel := range query.GetContextualTuples() {
+		contextualTuple, err := contextualTupleModel.ToTupleWithCondition()
+		if err != nil {
+			return nil, err
+		}
+
+		contextualTuples = append(contextualTuples, *contextualTuple)
+	}
+
+	return &client.ClientExpandRequest{
+		Relation:         query.GetRelation(),
+		Object:           query.GetObject(),
+		ContextualTuples: contextualTuples,
+	}, nil
+}
+
+func (wrapper *QueryClient) ExpandTree(ctx context.Context, storeId string, authorizationModelId string, model ExpandQueryModel, consistency string) (*openfga.UsersetTree, error) {
+	options := client.ClientExpandOptions{
+		StoreId:              openfga.PtrString(storeId),
+		AuthorizationModelId: openfga.PtrString(authorizationModelId),
+		Consistency:          relationshiptuple.ResolveConsistency(consistency, wrapper.consistency),
+	}
+
+	body, err := model.ToExpandRequest()
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := wrapper.client.Expand(ctx).Options(options).Body(*body).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	tree := response.GetTree()
+
+	return &tree, nil
+}
+
+func (wrapper *QueryClient) Expand(ctx context.Context, storeId string, authorizationModelId string, model ExpandQueryModel, consistency string) (*ExpandResultModel, error) {
+	tree, err := wrapper.ExpandTree(ctx, storeId, authorizationModelId, model, consistency)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewExpandResultModel(*tree)
+}
+
+// CompareCheck performs the given check queries against both authorization
+// models using the BatchCheck endpoint and returns the outcome of every query
+// in the order of the queries. Errors of single queries are part of the
+// outcome, while a failed request results in an error.
+func (wrapper *QueryClient) CompareCheck(ctx context.Context, storeId string, baseAuthorizationModelId string, candidateAuthorizationModelId string, models []CheckQueryModel, maxBatchSize int32, maxParallelRequests int32) ([]CheckComparisonModel, error) {
+	checks := map[string]CheckQueryModel{}
+	for index, model := range models {
+		checks[strconv.Itoa(index)] = model
 	}
 
-	response, err := wrapper.client.ListUsers(ctx).Options(options).Body(*body).Execute()
+	baseResults, err := wrapper.BatchCheck(ctx, storeId, baseAuthorizationModelId, checks, maxBatchSize, maxParallelRequests, "")
 	if err != nil {
-		return types.ListNull(types.StringType), err
+		return nil, fmt.Errorf("base authorization model: %s", err)
 	}
 
-	elements := []attr.Value{}
-	for _, user := range response.GetUsers() {
-		elements = append(elements, types.StringValue(fmt.Sprintf("%[1]s:%[2]s", user.Object.Type, user.Object.Id)))
+	candidateResults, err := wrapper.BatchCheck(ctx, storeId, candidateAuthorizationModelId, checks, maxBatchSize, maxParallelRequests, "")
+	if err != nil {
+		return nil, fmt.Errorf("candidate authorization model: %s", err)
+	}
+
+	comparisons := []CheckComparisonModel{}
+	for index, model := range models {
+		baseResult := baseResults[strconv.Itoa(index)]
+		candidateResult := candidateResults[strconv.Itoa(index)]
+
+		comparisons = append(comparisons, CheckComparisonModel{
+			RelationshipTupleModel: model.RelationshipTupleModel,
+			BaseResult:             baseResult.Allowed,
+			BaseError:              baseResult.Error,
+			CandidateResult:        candidateResult.Allowed,
+			CandidateError:         candidateResult.Error,
+		})
+	}
+
+	return comparisons, nil
+}
+
+func (query CheckQueryModel) ToBatchCheckItem(correlationId string) (*client.ClientBatchCheckItem, error) {
+	checkRequest, err := query.ToCheckRequest()
+	if err != nil {
+		return nil, err
+	}
+
+	return &client.ClientBatchCheckItem{
+		User:             checkRequest.User,
+		Relation:         checkRequest.Relation,
+		Object:           checkRequest.Object,
+		CorrelationId:    correlationId,
+		ContextualTuples: checkRequest.ContextualTuples,
+		Context:          checkRequest.Context,
+	}, nil
+}
+
+// BatchCheck performs the given check queries using the BatchCheck endpoint.
+// The queries are split into chunks of maxBatchSize, of which up to
+// maxParallelRequests are sent concurrently. As the server restricts the
+// format of correlation IDs, the keys of the queries are mapped to generated
+// correlation IDs.
+func (wrapper *QueryClient) BatchCheck(ctx context.Context, storeId string, authorizationModelId string, models map[string]CheckQueryModel, maxBatchSize int32, maxParallelRequests int32, consistency string) (map[string]BatchCheckResultModel, error) {
+	results := map[string]BatchCheckResultModel{}
+	if len(models) == 0 {
+		return results, nil
+	}
+
+	options := client.BatchCheckOptions{
+		StoreId:              openfga.PtrString(storeId),
+		AuthorizationModelId: openfga.PtrString(authorizationModelId),
+		MaxBatchSize:         openfga.PtrInt32(maxBatchSize),
+		MaxParallelRequests:  openfga.PtrInt32(maxParallelRequests),
+		Consistency:          relationshiptuple.ResolveConsistency(consistency, wrapper.consistency),
+	}
+
+	keys := []string{}
+	for key := range models {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	body := client.ClientBatchCheckRequest{
+		Checks: []client.ClientBatchCheckItem{},
+	}
+	for index, key := range keys {
+		item, err := models[key].ToBatchCheckItem(strconv.Itoa(index))
+		if err != nil {
+			return nil, fmt.Errorf("invalid check %q: %s", key, err)
+		}
+
+		body.Checks = append(body.Checks, *item)
+	}
+
+	cacheKey, err := querycache.Key("batch_check", storeId, authorizationModelId, options.Consistency, keys, body)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := wrapper.cache.Do(cacheKey, func() (interface{}, error) {
+		return wrapper.client.BatchCheck(ctx).Options(options).Body(body).Execute()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for index, key := range keys {
+		result, ok := response.(*openfga.BatchCheckResponse).GetResult()[strconv.Itoa(index)]
+		if !ok {
+			results[key] = *NewBatchCheckResultModelFromError("no result returned")
+			continue
+		}
+
+		if result.Error != nil {
+			results[key] = *NewBatchCheckResultModelFromError(result.Error.GetMessage())
+			continue
+		}
+
+		results[key] = *NewBatchCheckResultModel(result.GetAllowed())
 	}
 
-	return types.ListValueMust(types.StringType, elements), nil
+	return results, nil
 }
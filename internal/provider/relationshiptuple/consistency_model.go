@@ -0,0 +1,31 @@
+package relationshiptuple
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	openfga "github.com/openfga/go-sdk"
+)
+
+// ConsistencyValues are the supported values of a `consistency` attribute.
+var ConsistencyValues = []string{
+	string(openfga.CONSISTENCYPREFERENCE_MINIMIZE_LATENCY),
+	string(openfga.CONSISTENCYPREFERENCE_HIGHER_CONSISTENCY),
+}
+
+type ConsistencyModel struct {
+	Consistency types.String `tfsdk:"consistency"`
+}
+
+// ResolveConsistency returns the given consistency preference, falling back to
+// the default of the provider. It returns nil if neither is set, so that the
+// server default is used.
+func ResolveConsistency(consistency string, defaultConsistency openfga.ConsistencyPreference) *openfga.ConsistencyPreference {
+	if consistency == "" {
+		consistency = string(defaultConsistency)
+	}
+
+	if consistency == "" {
+		return nil
+	}
+
+	return openfga.ConsistencyPreference(consistency).Ptr()
+}
@@ -32,6 +32,12 @@ func (model ContextModel) GetContextMap() (*map[string]interface{}, error) {
 func NewContextModel(data *map[string]interface{}) *ContextModel {
 	context := jsontypes.NewNormalizedNull()
 
+	if data == nil {
+		return &ContextModel{
+			ContextJson: context,
+		}
+	}
+
 	jsonBytes, err := json.Marshal(data)
 	if err == nil {
 		context = jsontypes.NewNormalizedValue(string(jsonBytes))
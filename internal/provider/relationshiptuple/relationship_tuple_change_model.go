@@ -0,0 +1,61 @@
+package relationshiptuple
+
+import (
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	openfga "github.com/openfga/go-sdk"
+)
+
+type RelationshipTupleChangesQueryModel struct {
+	Type              types.String `tfsdk:"type"`
+	StartTime         types.String `tfsdk:"start_time"`
+	ContinuationToken types.String `tfsdk:"continuation_token"`
+	PageSize          types.Int64  `tfsdk:"page_size"`
+	MaxChanges        types.Int64  `tfsdk:"max_changes"`
+}
+
+func (model RelationshipTupleChangesQueryModel) GetType() string {
+	return model.Type.ValueString()
+}
+
+// GetStartTime returns the parsed start time, or the zero time if none is set.
+func (model RelationshipTupleChangesQueryModel) GetStartTime() (time.Time, error) {
+	if model.StartTime.IsNull() {
+		return time.Time{}, nil
+	}
+
+	return time.Parse(time.RFC3339, model.StartTime.ValueString())
+}
+
+func (model RelationshipTupleChangesQueryModel) GetContinuationToken() string {
+	return model.ContinuationToken.ValueString()
+}
+
+func (model RelationshipTupleChangesQueryModel) GetPageSize() int32 {
+	return int32(model.PageSize.ValueInt64())
+}
+
+func (model RelationshipTupleChangesQueryModel) GetMaxChanges() int {
+	return int(model.MaxChanges.ValueInt64())
+}
+
+type RelationshipTupleChangeModel struct {
+	Operation types.String `tfsdk:"operation"`
+	RelationshipTupleWithConditionModel
+	Timestamp types.String `tfsdk:"timestamp"`
+}
+
+// NewRelationshipTupleChangeModel creates a change from the ReadChanges API,
+// reporting the operation as either `write` or `delete`.
+func NewRelationshipTupleChangeModel(change openfga.TupleChange) *RelationshipTupleChangeModel {
+	operation := strings.ToLower(strings.TrimPrefix(string(change.GetOperation()), "TUPLE_OPERATION_"))
+	tuple := change.GetTupleKey()
+
+	return &RelationshipTupleChangeModel{
+		Operation:                           types.StringValue(operation),
+		RelationshipTupleWithConditionModel: *NewRelationshipTupleWithConditionModelFromTuple(&tuple),
+		Timestamp:                           types.StringValue(change.GetTimestamp().UTC().Format(time.RFC3339Nano)),
+	}
+}
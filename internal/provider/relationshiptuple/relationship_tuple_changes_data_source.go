@@ -0,0 +1,184 @@
+package relationshiptuple
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/openfga/terraform-provider-openfga/internal/provider/providerdata"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RelationshipTupleChangesDataSource{}
+var _ datasource.DataSourceWithConfigure = &RelationshipTupleChangesDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &RelationshipTupleChangesDataSource{}
+
+func NewRelationshipTupleChangesDataSource() datasource.DataSource {
+	return &RelationshipTupleChangesDataSource{}
+}
+
+type RelationshipTupleChangesDataSource struct {
+	client *RelationshipTupleClient
+}
+
+type RelationshipTupleChangesDataSourceModel struct {
+	StoreId types.String `tfsdk:"store_id"`
+	RelationshipTupleChangesQueryModel
+
+	Changes               []RelationshipTupleChangeModel `tfsdk:"changes"`
+	NextContinuationToken types.String                   `tfsdk:"next_continuation_token"`
+}
+
+func (d *RelationshipTupleChangesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_relationship_tuple_changes"
+}
+
+func (d *RelationshipTupleChangesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides the ability to read the history of relationship tuple writes and deletes in a specific store, e.g. for audit reports or to detect out-of-band changes. The returned `next_continuation_token` can be passed as `continuation_token` to a later read to only retrieve subsequent changes.",
+
+		Attributes: map[string]schema.Attribute{
+			"store_id": schema.StringAttribute{
+				MarkdownDescription: "The unique ID of the store to read the changes of.",
+				Required:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The object type to read the changes of. Can be left blank to read the changes of all types.",
+				Optional:            true,
+			},
+			"start_time": schema.StringAttribute{
+				MarkdownDescription: "The time to read changes from, as an RFC 3339 timestamp, e.g. `2024-01-01T00:00:00Z`. Can be left blank to read from the beginning of the store.",
+				Optional:            true,
+			},
+			"continuation_token": schema.StringAttribute{
+				MarkdownDescription: "The continuation token returned by a previous read, to read the changes after it.",
+				Optional:            true,
+			},
+			"page_size": schema.Int64Attribute{
+				MarkdownDescription: "The number of changes read per request. Defaults to the page size of the server.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+			"max_changes": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of changes to read. All changes are read if not set.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"changes": schema.ListNestedAttribute{
+				MarkdownDescription: "List of changes in the order they occurred.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"operation": schema.StringAttribute{
+							MarkdownDescription: "The operation of the change, either `write` or `delete`.",
+							Computed:            true,
+						},
+						"user": schema.StringAttribute{
+							MarkdownDescription: "The user of the relationship tuple.",
+							Computed:            true,
+						},
+						"relation": schema.StringAttribute{
+							MarkdownDescription: "The relation of the relationship tuple.",
+							Computed:            true,
+						},
+						"object": schema.StringAttribute{
+							MarkdownDescription: "The object of the relationship tuple.",
+							Computed:            true,
+						},
+						"condition": schema.SingleNestedAttribute{
+							MarkdownDescription: "A condition of the relationship tuple.",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									MarkdownDescription: "The name of the condition.",
+									Computed:            true,
+								},
+								"context_json": schema.StringAttribute{
+									MarkdownDescription: "The (partial) context under which the condition is evaluated.",
+									CustomType:          jsontypes.NormalizedType{},
+									Computed:            true,
+								},
+							},
+						},
+						"timestamp": schema.StringAttribute{
+							MarkdownDescription: "The time of the change, as an RFC 3339 timestamp.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"next_continuation_token": schema.StringAttribute{
+				MarkdownDescription: "The continuation token to read the changes after the returned ones.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d RelationshipTupleChangesDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("start_time"),
+			path.MatchRoot("continuation_token"),
+		),
+	}
+}
+
+func (d *RelationshipTupleChangesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providerdata.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = NewRelationshipTupleClient(providerData.Client, providerData.Consistency)
+}
+
+func (d *RelationshipTupleChangesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state RelationshipTupleChangesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	startTime, err := state.GetStartTime()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("start_time"), "Invalid Start Time", fmt.Sprintf("Unable to parse start time, got error: %s", err))
+		return
+	}
+
+	changes, continuationToken, err := d.client.ListRelationshipTupleChanges(ctx, state.StoreId.ValueString(), state.GetType(), startTime, state.GetContinuationToken(), state.GetPageSize(), state.GetMaxChanges())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read relationship tuple changes, got error: %s", err))
+		return
+	}
+
+	state.Changes = *changes
+	state.NextContinuationToken = types.StringValue(continuationToken)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
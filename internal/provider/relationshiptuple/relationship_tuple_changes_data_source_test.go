@@ -0,0 +1,177 @@
+package relationshiptuple_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/openfga/terraform-provider-openfga/internal/provider/acceptance"
+)
+
+func TestAccRelationshipTupleChangesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccRelationshipTupleChangesDataSourceConfig(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.openfga_relationship_tuple_changes.all",
+						tfjsonpath.New("changes"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.ObjectPartial(map[string]knownvalue.Check{
+								"operation": knownvalue.StringExact("write"),
+								"user":      knownvalue.StringExact("user:user-1"),
+								"relation":  knownvalue.StringExact("viewer"),
+								"object":    knownvalue.StringExact("document:document-1"),
+								"condition": knownvalue.Null(),
+								"timestamp": knownvalue.StringRegexp(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T`)),
+							}),
+							knownvalue.ObjectPartial(map[string]knownvalue.Check{
+								"operation": knownvalue.StringExact("write"),
+								"object":    knownvalue.StringExact("folder:folder-1"),
+							}),
+							knownvalue.ObjectPartial(map[string]knownvalue.Check{
+								"operation": knownvalue.StringExact("write"),
+								"object":    knownvalue.StringExact("document:document-2"),
+							}),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"data.openfga_relationship_tuple_changes.document",
+						tfjsonpath.New("changes"),
+						knownvalue.ListSizeExact(2),
+					),
+					statecheck.ExpectKnownValue(
+						"data.openfga_relationship_tuple_changes.first",
+						tfjsonpath.New("changes"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.ObjectPartial(map[string]knownvalue.Check{
+								"object": knownvalue.StringExact("document:document-1"),
+							}),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"data.openfga_relationship_tuple_changes.rest",
+						tfjsonpath.New("changes"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.ObjectPartial(map[string]knownvalue.Check{
+								"object": knownvalue.StringExact("folder:folder-1"),
+							}),
+							knownvalue.ObjectPartial(map[string]knownvalue.Check{
+								"object": knownvalue.StringExact("document:document-2"),
+							}),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"data.openfga_relationship_tuple_changes.rest",
+						tfjsonpath.New("next_continuation_token"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccRelationshipTupleChangesDataSourceConfig() string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "openfga_store" "test" {
+	name = "test"
+}
+
+data "openfga_authorization_model_document" "test" {
+	dsl = <<EOT
+model
+	schema 1.1
+
+type user
+
+type folder
+	relations
+		define viewer: [user]
+
+type document
+	relations
+		define viewer: [user]
+	EOT
+}
+
+resource "openfga_authorization_model" "test" {
+	store_id = openfga_store.test.id
+
+	model_json = data.openfga_authorization_model_document.test.result
+}
+
+resource "openfga_relationship_tuple" "first" {
+	store_id               = openfga_store.test.id
+	authorization_model_id = openfga_authorization_model.test.id
+
+	user     = "user:user-1"
+	relation = "viewer"
+	object   = "document:document-1"
+}
+
+resource "openfga_relationship_tuple" "second" {
+	depends_on = [openfga_relationship_tuple.first]
+
+	store_id               = openfga_store.test.id
+	authorization_model_id = openfga_authorization_model.test.id
+
+	user     = "user:user-1"
+	relation = "viewer"
+	object   = "folder:folder-1"
+}
+
+resource "openfga_relationship_tuple" "third" {
+	depends_on = [openfga_relationship_tuple.second]
+
+	store_id               = openfga_store.test.id
+	authorization_model_id = openfga_authorization_model.test.id
+
+	user     = "user:user-1"
+	relation = "viewer"
+	object   = "document:document-2"
+}
+
+data "openfga_relationship_tuple_changes" "all" {
+	depends_on = [openfga_relationship_tuple.third]
+
+	store_id = openfga_store.test.id
+
+	page_size = 1
+}
+
+data "openfga_relationship_tuple_changes" "document" {
+	depends_on = [openfga_relationship_tuple.third]
+
+	store_id = openfga_store.test.id
+
+	type       = "document"
+	start_time = "2020-01-01T00:00:00Z"
+}
+
+data "openfga_relationship_tuple_changes" "first" {
+	depends_on = [openfga_relationship_tuple.third]
+
+	store_id = openfga_store.test.id
+
+	max_changes = 1
+}
+
+data "openfga_relationship_tuple_changes" "rest" {
+	store_id = openfga_store.test.id
+
+	continuation_token = data.openfga_relationship_tuple_changes.first.next_continuation_token
+}
+`, acceptance.ProviderConfig)
+}
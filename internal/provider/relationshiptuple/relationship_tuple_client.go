@@ -2,19 +2,27 @@ package relationshiptuple
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"slices"
+	"time"
 
 	openfga "github.com/openfga/go-sdk"
 	"github.com/openfga/go-sdk/client"
 	internalError "github.com/openfga/terraform-provider-openfga/internal/apierror"
+	"github.com/openfga/terraform-provider-openfga/internal/writebatcher"
 )
 
+const maxTuplesPerWrite = 100
+
 type RelationshipTupleClient struct {
-	client *client.OpenFgaClient
+	client      *client.OpenFgaClient
+	consistency openfga.ConsistencyPreference
 }
 
-func NewRelationshipTupleClient(client *client.OpenFgaClient) *RelationshipTupleClient {
-	return &RelationshipTupleClient{client: client}
+func NewRelationshipTupleClient(client *client.OpenFgaClient, consistency openfga.ConsistencyPreference) *RelationshipTupleClient {
+	return &RelationshipTupleClient{client: client, consistency: consistency}
 }
 
 func (model RelationshipTupleWithConditionModel) ToCreateRequest() (*client.ClientWriteTuplesBody, error) {
@@ -54,6 +62,22 @@ func (wrapper *RelationshipTupleClient) CreateRelationshipTuple(ctx context.Cont
 	return NewRelationshipTupleWithConditionModelFromTuple(&tuple), nil
 }
 
+// CreateRelationshipTupleBatched writes the relationship tuple as part of the
+// next batch of the write batcher.
+func (wrapper *RelationshipTupleClient) CreateRelationshipTupleBatched(ctx context.Context, batcher *writebatcher.Batcher, storeId string, authorizationModelId *string, model RelationshipTupleWithConditionModel) (*RelationshipTupleWithConditionModel, error) {
+	tuple, err := model.ToTupleWithCondition()
+	if err != nil {
+		return nil, err
+	}
+
+	err = batcher.Write(ctx, storeId, authorizationModelId, *tuple)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRelationshipTupleWithConditionModelFromTuple(tuple), nil
+}
+
 func (model RelationshipTupleModel) ToReadRequest() *client.ClientReadRequest {
 	tuple := model.ToTuple()
 
@@ -64,9 +88,10 @@ func (model RelationshipTupleModel) ToReadRequest() *client.ClientReadRequest {
 	}
 }
 
-func (wrapper *RelationshipTupleClient) ReadRelationshipTuple(ctx context.Context, storeId string, model RelationshipTupleModel) (*RelationshipTupleWithConditionModel, error) {
+func (wrapper *RelationshipTupleClient) ReadRelationshipTuple(ctx context.Context, storeId string, model RelationshipTupleModel, consistency string) (*RelationshipTupleWithConditionModel, error) {
 	options := client.ClientReadOptions{
-		StoreId: openfga.PtrString(storeId),
+		StoreId:     openfga.PtrString(storeId),
+		Consistency: ResolveConsistency(consistency, wrapper.consistency),
 	}
 
 	body := model.ToReadRequest()
@@ -87,41 +112,206 @@ func (wrapper *RelationshipTupleClient) ReadRelationshipTuple(ctx context.Contex
 	return NewRelationshipTupleWithConditionModelFromTuple(&tuple), nil
 }
 
-func (wrapper *RelationshipTupleClient) ListRelationshipTuples(ctx context.Context, storeId string, query *RelationshipTupleModel) (*[]RelationshipTupleWithConditionModel, error) {
-	options := client.ClientReadOptions{
-		StoreId:           openfga.PtrString(storeId),
-		ContinuationToken: openfga.PtrString(""),
+func (wrapper *RelationshipTupleClient) ListRelationshipTuples(ctx context.Context, storeId string, query *RelationshipTupleModel, consistency string) (*[]RelationshipTupleWithConditionModel, error) {
+	relationshipTupleModels, _, err := wrapper.scanRelationshipTuples(ctx, storeId, query, consistency, ScanOptions{})
+
+	return relationshipTupleModels, err
+}
+
+// ListRelationshipTuplesPage lists the relationship tuples matching the query
+// within the range of the scan options, and returns the continuation token
+// after the last of them. The filters of the query which the Read API does not
+// support are applied to the read tuples.
+func (wrapper *RelationshipTupleClient) ListRelationshipTuplesPage(ctx context.Context, storeId string, query *RelationshipTuplesQueryModel, consistency string, scanOptions ScanOptions) (*[]RelationshipTupleWithConditionModel, string, error) {
+	if query == nil {
+		query = &RelationshipTuplesQueryModel{}
 	}
 
-	body := client.ClientReadRequest{}
-	if query != nil {
-		body = *query.ToReadRequest()
+	if !query.RequiresTypeScan() {
+		if query.RequiresFilter(query.GetObjectType()) {
+			scanOptions.Filter = query.Matches
+		}
+
+		return wrapper.scanRelationshipTuples(ctx, storeId, query.ToReadQuery(query.GetObjectType()), consistency, scanOptions)
 	}
 
-	tuples := []openfga.TupleKey{}
+	response, err := wrapper.client.ReadLatestAuthorizationModel(ctx).Options(client.ClientReadLatestAuthorizationModelOptions{
+		StoreId: openfga.PtrString(storeId),
+	}).Execute()
+	if err != nil {
+		return nil, "", err
+	}
 
-	for isLastPage := false; !isLastPage; isLastPage = *options.ContinuationToken == "" {
-		response, err := wrapper.client.Read(ctx).Options(options).Body(body).Execute()
-		if err != nil {
-			return nil, err
+	return wrapper.scanRelationshipTuplesByType(ctx, storeId, query, consistency, objectTypesOf(response.AuthorizationModel), scanOptions)
+}
+
+// scanRelationshipTuplesByType reads the relationship tuples of every object
+// type in turn, as the Read API requires an object type if a user is given.
+// The continuation token identifies the object type besides the position
+// within its tuples.
+func (wrapper *RelationshipTupleClient) scanRelationshipTuplesByType(ctx context.Context, storeId string, query *RelationshipTuplesQueryModel, consistency string, objectTypes []string, scanOptions ScanOptions) (*[]RelationshipTupleWithConditionModel, string, error) {
+	position, err := decodeTypeScanToken(scanOptions.ContinuationToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	start := 0
+	if position.ObjectType != "" {
+		start = slices.Index(objectTypes, position.ObjectType)
+		if start < 0 {
+			return nil, "", fmt.Errorf("invalid continuation token: unknown object type %s", position.ObjectType)
 		}
+	}
 
-		for _, element := range response.Tuples {
-			tuples = append(tuples, element.Key)
+	if scanOptions.StartAfter != nil {
+		startAfterType := slices.Index(objectTypes, typeOf(scanOptions.StartAfter.GetObject()))
+		if startAfterType < 0 {
+			return nil, "", fmt.Errorf("relationship tuple to start after (user=%s, relation=%s, object=%s) does not exist", scanOptions.StartAfter.GetUser(), scanOptions.StartAfter.GetRelation(), scanOptions.StartAfter.GetObject())
 		}
+		start = max(start, startAfterType)
+	}
+
+	relationshipTupleModels := []RelationshipTupleWithConditionModel{}
+	for index := start; index < len(objectTypes); index++ {
+		objectType := objectTypes[index]
 
-		options.ContinuationToken = openfga.PtrString(response.ContinuationToken)
+		options := scanOptions
+		options.ContinuationToken = ""
+		if objectType == position.ObjectType {
+			options.ContinuationToken = position.ContinuationToken
+		}
+		if options.StartAfter != nil && typeOf(options.StartAfter.GetObject()) != objectType {
+			options.StartAfter = nil
+		}
+		if query.RequiresFilter(objectType) {
+			options.Filter = query.Matches
+		}
+		if scanOptions.MaxResults > 0 {
+			options.MaxResults = scanOptions.MaxResults - len(relationshipTupleModels)
+		}
+
+		page, continuationToken, err := wrapper.scanRelationshipTuples(ctx, storeId, query.ToReadQuery(objectType), consistency, options)
+		if err != nil {
+			return nil, "", err
+		}
+
+		relationshipTupleModels = append(relationshipTupleModels, *page...)
+
+		if scanOptions.MaxResults > 0 && len(relationshipTupleModels) >= scanOptions.MaxResults {
+			if continuationToken == "" {
+				if index+1 == len(objectTypes) {
+					return &relationshipTupleModels, "", nil
+				}
+				objectType = objectTypes[index+1]
+			}
+
+			token, err := encodeTypeScanToken(typeScanToken{ObjectType: objectType, ContinuationToken: continuationToken})
+			if err != nil {
+				return nil, "", err
+			}
+
+			return &relationshipTupleModels, token, nil
+		}
 	}
 
+	return &relationshipTupleModels, "", nil
+}
+
+func (wrapper *RelationshipTupleClient) scanRelationshipTuples(ctx context.Context, storeId string, query *RelationshipTupleModel, consistency string, scanOptions ScanOptions) (*[]RelationshipTupleWithConditionModel, string, error) {
+	scanner := wrapper.ScanRelationshipTuples(ctx, storeId, query, consistency, scanOptions)
+
 	relationshipTupleModels := []RelationshipTupleWithConditionModel{}
-	for _, tuple := range tuples {
-		relationshipTupleModels = append(
-			relationshipTupleModels,
-			*NewRelationshipTupleWithConditionModelFromTuple(&tuple),
-		)
+	for scanner.Next() {
+		relationshipTupleModels = append(relationshipTupleModels, scanner.Tuple())
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, "", err
+	}
+
+	return &relationshipTupleModels, scanner.ContinuationToken(), nil
+}
+
+// typeScanToken is the position of a scan over the relationship tuples of
+// every object type.
+type typeScanToken struct {
+	ObjectType        string `json:"object_type"`
+	ContinuationToken string `json:"continuation_token"`
+}
+
+func encodeTypeScanToken(position typeScanToken) (string, error) {
+	token, err := json.Marshal(position)
+	if err != nil {
+		return "", err
 	}
 
-	return &relationshipTupleModels, nil
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
+
+func decodeTypeScanToken(token string) (typeScanToken, error) {
+	position := typeScanToken{}
+	if token == "" {
+		return position, nil
+	}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return position, fmt.Errorf("invalid continuation token: %w", err)
+	}
+
+	if err := json.Unmarshal(decoded, &position); err != nil {
+		return position, fmt.Errorf("invalid continuation token: %w", err)
+	}
+
+	return position, nil
+}
+
+// ListRelationshipTupleChanges reads the changes of the store in the order
+// they occurred, starting after the continuation token or at the start time.
+// Reading stops once maxChanges were read or no further changes exist, and the
+// continuation token to resume from is returned. A pageSize or maxChanges of
+// zero applies the server default or no limit respectively.
+func (wrapper *RelationshipTupleClient) ListRelationshipTupleChanges(ctx context.Context, storeId string, type_ string, startTime time.Time, continuationToken string, pageSize int32, maxChanges int) (*[]RelationshipTupleChangeModel, string, error) {
+	options := client.ClientReadChangesOptions{
+		StoreId:           openfga.PtrString(storeId),
+		ContinuationToken: openfga.PtrString(continuationToken),
+	}
+
+	body := client.ClientReadChangesRequest{
+		Type:      type_,
+		StartTime: startTime,
+	}
+
+	changes := []RelationshipTupleChangeModel{}
+
+	for maxChanges <= 0 || len(changes) < maxChanges {
+		// Never request more changes than remain, so that the continuation
+		// token points right after the last returned change.
+		remaining := 0
+		if maxChanges > 0 {
+			remaining = maxChanges - len(changes)
+		}
+		options.PageSize = limitPageSize(pageSize, remaining)
+
+		response, err := wrapper.client.ReadChanges(ctx).Options(options).Body(body).Execute()
+		if err != nil {
+			return nil, "", err
+		}
+
+		if response.GetContinuationToken() != "" {
+			options.ContinuationToken = openfga.PtrString(response.GetContinuationToken())
+		}
+
+		if len(response.GetChanges()) == 0 {
+			break
+		}
+
+		for _, change := range response.GetChanges() {
+			changes = append(changes, *NewRelationshipTupleChangeModel(change))
+		}
+	}
+
+	return &changes, *options.ContinuationToken, nil
 }
 
 func (model RelationshipTupleModel) ToDeleteRequest() *client.ClientDeleteTuplesBody {
@@ -150,3 +340,80 @@ func (wrapper *RelationshipTupleClient) DeleteRelationshipTuple(ctx context.Cont
 
 	return nil
 }
+
+// DeleteRelationshipTupleBatched deletes the relationship tuple as part of the
+// next batch of the write batcher.
+func (wrapper *RelationshipTupleClient) DeleteRelationshipTupleBatched(ctx context.Context, batcher *writebatcher.Batcher, storeId string, authorizationModelId *string, model RelationshipTupleWithConditionModel) error {
+	return batcher.Delete(ctx, storeId, authorizationModelId, *model.ToTuple())
+}
+
+func (wrapper *RelationshipTupleClient) CreateRelationshipTuples(ctx context.Context, storeId string, authorizationModelId *string, models []RelationshipTupleWithConditionModel) error {
+	options := client.ClientWriteOptions{
+		StoreId:              openfga.PtrString(storeId),
+		AuthorizationModelId: authorizationModelId,
+		Transaction: &client.TransactionOptions{
+			Disable:     true,
+			MaxPerChunk: maxTuplesPerWrite,
+		},
+	}
+
+	body := client.ClientWriteTuplesBody{}
+	for _, model := range models {
+		tuple, err := model.ToTupleWithCondition()
+		if err != nil {
+			return err
+		}
+
+		body = append(body, *tuple)
+	}
+
+	if len(body) == 0 {
+		return nil
+	}
+
+	response, err := wrapper.client.WriteTuples(ctx).Options(options).Body(body).Execute()
+	if err != nil {
+		return err
+	}
+
+	for _, writeResult := range response.Writes {
+		if writeResult.Error != nil {
+			return writeResult.Error
+		}
+	}
+
+	return nil
+}
+
+func (wrapper *RelationshipTupleClient) DeleteRelationshipTuples(ctx context.Context, storeId string, authorizationModelId *string, models []RelationshipTupleWithConditionModel) error {
+	options := client.ClientWriteOptions{
+		StoreId:              openfga.PtrString(storeId),
+		AuthorizationModelId: authorizationModelId,
+		Transaction: &client.TransactionOptions{
+			Disable:     true,
+			MaxPerChunk: maxTuplesPerWrite,
+		},
+	}
+
+	body := client.ClientDeleteTuplesBody{}
+	for _, model := range models {
+		body = append(body, *model.ToTuple())
+	}
+
+	if len(body) == 0 {
+		return nil
+	}
+
+	response, err := wrapper.client.DeleteTuples(ctx).Options(options).Body(body).Execute()
+	if err != nil {
+		return err
+	}
+
+	for _, deleteResult := range response.Deletes {
+		if deleteResult.Error != nil {
+			return deleteResult.Error
+		}
+	}
+
+	return nil
+}
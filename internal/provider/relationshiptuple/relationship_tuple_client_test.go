@@ -0,0 +1,162 @@
+package relationshiptuple
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	openfga "github.com/openfga/go-sdk"
+	"github.com/openfga/go-sdk/client"
+)
+
+// testReadChangesServer serves a fixed number of changes page by page, and
+// rejects page sizes which the OpenFGA server does not accept.
+type testReadChangesServer struct {
+	changes int
+
+	mutex     sync.Mutex
+	pageSizes []int
+}
+
+func (server *testReadChangesServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	pageSize := 50
+	if value := r.URL.Query().Get("page_size"); value != "" {
+		pageSize, _ = strconv.Atoi(value)
+	}
+
+	server.mutex.Lock()
+	server.pageSizes = append(server.pageSizes, pageSize)
+	server.mutex.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	if pageSize < 1 || pageSize > maxPageSize {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"code":"validation_error","message":"invalid ReadChangesRequest.PageSize"}`))
+		return
+	}
+
+	offset := 0
+	if value := r.URL.Query().Get("continuation_token"); value != "" {
+		offset, _ = strconv.Atoi(value)
+	}
+
+	response := openfga.ReadChangesResponse{Changes: []openfga.TupleChange{}}
+	for index := offset; index < min(offset+pageSize, server.changes); index++ {
+		response.Changes = append(response.Changes, openfga.TupleChange{
+			TupleKey: openfga.TupleKey{
+				User:     fmt.Sprintf("user:%d", index),
+				Relation: "viewer",
+				Object:   "document:1",
+			},
+			Operation: openfga.TUPLEOPERATION_WRITE,
+			Timestamp: time.Unix(int64(index), 0),
+		})
+	}
+	response.ContinuationToken = openfga.PtrString(strconv.Itoa(offset + len(response.Changes)))
+
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+func TestListRelationshipTupleChanges(t *testing.T) {
+	server := &testReadChangesServer{changes: 300}
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+
+	fgaClient, err := client.NewSdkClient(&client.ClientConfiguration{
+		ApiUrl: httpServer.URL,
+	})
+	if err != nil {
+		t.Fatalf("unable to create client: %s", err)
+	}
+
+	wrapper := NewRelationshipTupleClient(fgaClient, "")
+
+	changes, continuationToken, err := wrapper.ListRelationshipTupleChanges(context.Background(), testStoreId, "", time.Time{}, "", 0, 250)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(*changes) != 250 {
+		t.Fatalf("expected 250 changes, got %d", len(*changes))
+	}
+
+	if continuationToken != "250" {
+		t.Fatalf("expected the continuation token after the last change, got %q", continuationToken)
+	}
+
+	expectedPageSizes := []int{100, 100, 50}
+	if fmt.Sprint(server.pageSizes) != fmt.Sprint(expectedPageSizes) {
+		t.Fatalf("expected page sizes %v, got %v", expectedPageSizes, server.pageSizes)
+	}
+}
+
+func TestListRelationshipTuplesPage(t *testing.T) {
+	t.Run("applies supported filters on the server", func(t *testing.T) {
+		wrapper, server := newTestRelationshipTupleClient(t, 300)
+
+		query := &RelationshipTuplesQueryModel{
+			Relation: types.StringValue("viewer"),
+			Object:   types.StringValue("document:1"),
+		}
+
+		tuples, _, err := wrapper.ListRelationshipTuplesPage(context.Background(), testStoreId, query, "", ScanOptions{MaxResults: 5})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if len(*tuples) != 5 {
+			t.Fatalf("expected 5 tuples, got %d", len(*tuples))
+		}
+
+		if len(server.tupleKeys) != 1 || server.tupleKeys[0].GetRelation() != "viewer" || server.tupleKeys[0].GetObject() != "document:1" {
+			t.Fatalf("expected a single read of the relation and object, got %v", server.tupleKeys)
+		}
+
+		expectedPageSizes := []int32{5}
+		if fmt.Sprint(server.pageSizes) != fmt.Sprint(expectedPageSizes) {
+			t.Fatalf("expected page sizes %v, got %v", expectedPageSizes, server.pageSizes)
+		}
+	})
+
+	t.Run("does not read a page per tuple for other filters", func(t *testing.T) {
+		wrapper, server := newTestRelationshipTupleClient(t, 300)
+
+		query := &RelationshipTuplesQueryModel{
+			UserType:     types.StringValue("user"),
+			HasCondition: types.BoolValue(false),
+		}
+
+		tuples, _, err := wrapper.ListRelationshipTuplesPage(context.Background(), testStoreId, query, "", ScanOptions{MaxResults: 1})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if len(*tuples) != 1 || len(server.pageSizes) != 1 {
+			t.Fatalf("expected 1 tuple from a single request, got %d tuples from %d requests", len(*tuples), len(server.pageSizes))
+		}
+	})
+
+	t.Run("reads full pages for selective filters", func(t *testing.T) {
+		wrapper, server := newTestRelationshipTupleClient(t, 300)
+
+		query := &RelationshipTuplesQueryModel{
+			HasCondition: types.BoolValue(true),
+		}
+
+		tuples, _, err := wrapper.ListRelationshipTuplesPage(context.Background(), testStoreId, query, "", ScanOptions{MaxResults: 1})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if len(*tuples) != 0 || len(server.pageSizes) != 3 {
+			t.Fatalf("expected no tuples from 3 requests, got %d tuples from %d requests", len(*tuples), len(server.pageSizes))
+		}
+	})
+}
@@ -5,11 +5,13 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
-	"github.com/openfga/go-sdk/client"
+	"github.com/openfga/terraform-provider-openfga/internal/provider/providerdata"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -27,6 +29,7 @@ type RelationshipTupleDataSource struct {
 type RelationshipTupleDataSourceModel struct {
 	StoreId types.String `tfsdk:"store_id"`
 	RelationshipTupleWithConditionModel
+	ConsistencyModel
 }
 
 func (d *RelationshipTupleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -69,6 +72,13 @@ func (d *RelationshipTupleDataSource) Schema(ctx context.Context, req datasource
 					},
 				},
 			},
+			"consistency": schema.StringAttribute{
+				MarkdownDescription: "The consistency preference of the read, either `MINIMIZE_LATENCY` or `HIGHER_CONSISTENCY`. Defaults to the consistency preference of the provider.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(ConsistencyValues...),
+				},
+			},
 		},
 	}
 }
@@ -79,18 +89,18 @@ func (d *RelationshipTupleDataSource) Configure(ctx context.Context, req datasou
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.OpenFgaClient)
+	providerData, ok := req.ProviderData.(*providerdata.ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.OpenFgaClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	d.client = NewRelationshipTupleClient(client)
+	d.client = NewRelationshipTupleClient(providerData.Client, providerData.Consistency)
 }
 
 func (d *RelationshipTupleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -102,7 +112,7 @@ func (d *RelationshipTupleDataSource) Read(ctx context.Context, req datasource.R
 		return
 	}
 
-	relationshipTupleModel, err := d.client.ReadRelationshipTuple(ctx, state.StoreId.ValueString(), state.RelationshipTupleModel)
+	relationshipTupleModel, err := d.client.ReadRelationshipTuple(ctx, state.StoreId.ValueString(), state.RelationshipTupleModel, state.Consistency.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read relationship tuple, got error: %s", err))
 		return
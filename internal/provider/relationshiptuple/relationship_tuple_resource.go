@@ -2,31 +2,50 @@ package relationshiptuple
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	openfga "github.com/openfga/go-sdk"
 
-	"github.com/openfga/go-sdk/client"
 	internalError "github.com/openfga/terraform-provider-openfga/internal/apierror"
+	"github.com/openfga/terraform-provider-openfga/internal/changefeed"
+	"github.com/openfga/terraform-provider-openfga/internal/provider/providerdata"
+	"github.com/openfga/terraform-provider-openfga/internal/querycache"
+	"github.com/openfga/terraform-provider-openfga/internal/writebatcher"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &RelationshipTupleResource{}
 var _ resource.ResourceWithImportState = &RelationshipTupleResource{}
 
+// writtenPrivateStateKey marks a relationship tuple that was just written, so
+// that the subsequent refresh does not observe a stale state.
+const writtenPrivateStateKey = "written"
+
+// changeTokenPrivateStateKey holds the position in the changes of the store
+// at the time the relationship tuple was last observed, so that the next
+// refresh only needs to consider the changes since then.
+const changeTokenPrivateStateKey = "change_token"
+
 func NewRelationshipTupleResource() resource.Resource {
 	return &RelationshipTupleResource{}
 }
 
 type RelationshipTupleResource struct {
-	client *RelationshipTupleClient
+	client         *RelationshipTupleClient
+	changeFeed     *changefeed.Feed
+	tupleSnapshots *querycache.Cache
+	writeBatcher   *writebatcher.Batcher
 }
 
 type RelationshipTupleResourceModel struct {
@@ -119,17 +138,20 @@ func (r *RelationshipTupleResource) Configure(ctx context.Context, req resource.
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.OpenFgaClient)
+	providerData, ok := req.ProviderData.(*providerdata.ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.OpenFgaClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = NewRelationshipTupleClient(client)
+	r.client = NewRelationshipTupleClient(providerData.Client, providerData.Consistency)
+	r.changeFeed = providerData.ChangeFeed
+	r.tupleSnapshots = providerData.TupleSnapshots
+	r.writeBatcher = providerData.WriteBatcher
 }
 
 func (r *RelationshipTupleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -141,7 +163,15 @@ func (r *RelationshipTupleResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
-	relationshipTupleModel, err := r.client.CreateRelationshipTuple(ctx, state.StoreId.ValueString(), state.AuthorizationModelId.ValueStringPointer(), state.RelationshipTupleWithConditionModel)
+	changePosition := r.readChangeHead(ctx, state.StoreId.ValueString())
+
+	var relationshipTupleModel *RelationshipTupleWithConditionModel
+	var err error
+	if r.writeBatcher != nil {
+		relationshipTupleModel, err = r.client.CreateRelationshipTupleBatched(ctx, r.writeBatcher, state.StoreId.ValueString(), state.AuthorizationModelId.ValueStringPointer(), state.RelationshipTupleWithConditionModel)
+	} else {
+		relationshipTupleModel, err = r.client.CreateRelationshipTuple(ctx, state.StoreId.ValueString(), state.AuthorizationModelId.ValueStringPointer(), state.RelationshipTupleWithConditionModel)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create relationship tuple, got error: %s", err))
 		return
@@ -149,6 +179,8 @@ func (r *RelationshipTupleResource) Create(ctx context.Context, req resource.Cre
 
 	state.RelationshipTupleWithConditionModel = *relationshipTupleModel
 
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, writtenPrivateStateKey, []byte("true"))...)
+	resp.Diagnostics.Append(setChangePosition(ctx, resp.Private, changePosition)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -161,17 +193,84 @@ func (r *RelationshipTupleResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
-	relationshipTupleModel, err := r.client.ReadRelationshipTuple(ctx, state.StoreId.ValueString(), state.RelationshipTupleModel)
+	written, diags := req.Private.GetKey(ctx, writtenPrivateStateKey)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unless the tuple was just written, it is looked up in the snapshot of all
+	// tuples of the store, if enabled.
+	if written == nil && r.tupleSnapshots != nil {
+		snapshot, err := r.readTupleSnapshot(ctx, state.StoreId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read relationship tuples, got error: %s", err))
+			return
+		}
+
+		relationshipTupleModel, ok := snapshot[tupleSnapshotKey(state.RelationshipTupleModel)]
+		if !ok {
+			removeMissingRelationshipTuple(ctx, state, resp)
+			return
+		}
+
+		state.RelationshipTupleWithConditionModel = relationshipTupleModel
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+
+	changePosition, diags := getChangePosition(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unless the tuple was just written, it is refreshed from the changes of
+	// the store since it was last observed. If the position is invalid or
+	// expired, the tuple is read individually instead.
+	if written == nil && !changePosition.IsZero() && r.changeFeed != nil {
+		changes, err := r.changeFeed.Changes(ctx, state.StoreId.ValueString(), changePosition)
+		if err != nil {
+			tflog.Debug(ctx, "Unable to read changes of store, reading relationship tuple individually", map[string]interface{}{
+				"store_id": state.StoreId.ValueString(),
+				"error":    err.Error(),
+			})
+		} else {
+			change, changed := changes.Lookup(state.GetUser(), state.GetRelation(), state.GetObject())
+			if changed && change.GetOperation() == openfga.TUPLEOPERATION_DELETE {
+				removeMissingRelationshipTuple(ctx, state, resp)
+				return
+			}
+
+			if changed {
+				tuple := change.GetTupleKey()
+				state.RelationshipTupleWithConditionModel = *NewRelationshipTupleWithConditionModelFromTuple(&tuple)
+			}
+
+			resp.Diagnostics.Append(setChangePosition(ctx, resp.Private, changes.Head)...)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			return
+		}
+	}
+
+	// The position is determined before the read, so that no change after the
+	// read can be missed by the next refresh.
+	changePosition = r.readChangeHead(ctx, state.StoreId.ValueString())
+
+	// Refreshes right after a write use higher consistency, as the tuple might
+	// not be visible yet otherwise.
+	consistency := ""
+	if written != nil {
+		consistency = string(openfga.CONSISTENCYPREFERENCE_HIGHER_CONSISTENCY)
+	}
+
+	relationshipTupleModel, err := r.client.ReadRelationshipTuple(ctx, state.StoreId.ValueString(), state.RelationshipTupleModel, consistency)
 	if err != nil {
 		if internalError.IsExpectedOneResultError(err) {
-			resp.Diagnostics.AddWarning(
-				"relationship tuple not found",
-				fmt.Sprintf("relationship tuple (user: %q, relation: %q, object: %q) no longer exists; removing from state.",
-					state.User.ValueString(),
-					state.Relation.ValueString(),
-					state.Object.ValueString()),
-			)
-			resp.State.RemoveResource(ctx)
+			removeMissingRelationshipTuple(ctx, state, resp)
 			return
 		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read relationship tuple, got error: %s", err))
@@ -180,9 +279,117 @@ func (r *RelationshipTupleResource) Read(ctx context.Context, req resource.ReadR
 
 	state.RelationshipTupleWithConditionModel = *relationshipTupleModel
 
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, writtenPrivateStateKey, nil)...)
+	resp.Diagnostics.Append(setChangePosition(ctx, resp.Private, changePosition)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+func removeMissingRelationshipTuple(ctx context.Context, state RelationshipTupleResourceModel, resp *resource.ReadResponse) {
+	resp.Diagnostics.AddWarning(
+		"relationship tuple not found",
+		fmt.Sprintf("relationship tuple (user: %q, relation: %q, object: %q) no longer exists; removing from state.",
+			state.User.ValueString(),
+			state.Relation.ValueString(),
+			state.Object.ValueString()),
+	)
+	resp.State.RemoveResource(ctx)
+}
+
+// readTupleSnapshot returns all relationship tuples of the store, which are
+// read at most once per run. The snapshot is shared by all refreshes of the
+// store, so it is not read with the cancellation of the first of them.
+func (r *RelationshipTupleResource) readTupleSnapshot(ctx context.Context, storeId string) (map[string]RelationshipTupleWithConditionModel, error) {
+	snapshot, err := r.tupleSnapshots.Do(storeId, func() (interface{}, error) {
+		scanner := r.client.ScanRelationshipTuples(context.WithoutCancel(ctx), storeId, nil, "", ScanOptions{})
+
+		snapshot := map[string]RelationshipTupleWithConditionModel{}
+		for scanner.Next() {
+			relationshipTupleModel := scanner.Tuple()
+			snapshot[tupleSnapshotKey(relationshipTupleModel.RelationshipTupleModel)] = relationshipTupleModel
+		}
+
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+
+		return snapshot, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshot.(map[string]RelationshipTupleWithConditionModel), nil
+}
+
+func tupleSnapshotKey(model RelationshipTupleModel) string {
+	return model.GetUser() + " " + model.GetRelation() + " " + model.GetObject()
+}
+
+// readChangeHead returns the position from which all later changes of the
+// store can be read, or an empty position if incremental refreshes are
+// disabled or the position cannot be determined. In the latter case, the next
+// refresh reads the relationship tuple individually.
+func (r *RelationshipTupleResource) readChangeHead(ctx context.Context, storeId string) changefeed.Position {
+	if r.changeFeed == nil {
+		return changefeed.Position{}
+	}
+
+	head, err := r.changeFeed.Head(ctx, storeId)
+	if err != nil {
+		tflog.Warn(ctx, "Unable to determine position in changes of store, the next refresh reads the relationship tuple individually", map[string]interface{}{
+			"store_id": storeId,
+			"error":    err.Error(),
+		})
+
+		return changefeed.Position{}
+	}
+
+	return head
+}
+
+// privateState is implemented by the private state of all resource responses.
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// getChangePosition returns the stored position, which earlier versions
+// stored as a plain continuation token. An unreadable position is ignored, so
+// that the relationship tuple is read individually.
+func getChangePosition(ctx context.Context, private privateState) (changefeed.Position, diag.Diagnostics) {
+	value, diags := private.GetKey(ctx, changeTokenPrivateStateKey)
+	if diags.HasError() || value == nil {
+		return changefeed.Position{}, diags
+	}
+
+	var changeToken string
+	if err := json.Unmarshal(value, &changeToken); err == nil {
+		return changefeed.Position{ContinuationToken: changeToken}, diags
+	}
+
+	var changePosition changefeed.Position
+	if err := json.Unmarshal(value, &changePosition); err != nil {
+		return changefeed.Position{}, diags
+	}
+
+	return changePosition, diags
+}
+
+// setChangePosition stores the position as JSON, as the values of private
+// state have to be valid JSON. An empty position removes the key.
+func setChangePosition(ctx context.Context, private privateState, changePosition changefeed.Position) diag.Diagnostics {
+	if changePosition.IsZero() {
+		return private.SetKey(ctx, changeTokenPrivateStateKey, nil)
+	}
+
+	value, err := json.Marshal(changePosition)
+	if err != nil {
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Private State Error", err.Error())}
+	}
+
+	return private.SetKey(ctx, changeTokenPrivateStateKey, value)
+}
+
 func (r *RelationshipTupleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Update is not supported and should never be called
 	resp.Diagnostics.AddError(
@@ -200,7 +407,12 @@ func (r *RelationshipTupleResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
-	err := r.client.DeleteRelationshipTuple(ctx, state.StoreId.ValueString(), state.AuthorizationModelId.ValueStringPointer(), state.RelationshipTupleWithConditionModel)
+	var err error
+	if r.writeBatcher != nil {
+		err = r.client.DeleteRelationshipTupleBatched(ctx, r.writeBatcher, state.StoreId.ValueString(), state.AuthorizationModelId.ValueStringPointer(), state.RelationshipTupleWithConditionModel)
+	} else {
+		err = r.client.DeleteRelationshipTuple(ctx, state.StoreId.ValueString(), state.AuthorizationModelId.ValueStringPointer(), state.RelationshipTupleWithConditionModel)
+	}
 	if err != nil {
 		if internalError.IsExpectedOneResultError(err) {
 			resp.State.RemoveResource(ctx)
@@ -3,6 +3,7 @@ package relationshiptuple_test
 import (
 	"fmt"
 	"os/exec"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -193,6 +194,192 @@ func TestAccRelationshipTupleResource(t *testing.T) {
 	})
 }
 
+func TestAccRelationshipTupleResourceRefresh(t *testing.T) {
+	for _, providerOptions := range []string{
+		"incremental_refresh = true",
+		"incremental_refresh = false",
+		"snapshot_refresh = true",
+		"max_concurrent_requests = 2",
+		"write_limits = { max_concurrent_requests = 1, requests_per_second = 20 }",
+	} {
+		t.Run(providerOptions, func(t *testing.T) {
+			var storeID string
+
+			resource.Test(t, resource.TestCase{
+				PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+				ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					// Create testing
+					{
+						Config: testAccRelationshipTupleResourceRefreshConfig(providerOptions),
+						Check: func(s *terraform.State) error {
+							storeID = s.RootModule().Resources["openfga_store.test"].Primary.ID
+							return nil
+						},
+					},
+					// Refresh testing, which records the position in the changes
+					{
+						RefreshState: true,
+					},
+					// Drift testing: change externally, then refresh
+					{
+						PreConfig: func() {
+							jsonBody := `{
+								"deletes":{"tuple_keys":[{"user":"user:user-1","relation":"viewer","object":"document:document-1"}]},
+								"writes":{"tuple_keys":[{"user":"user:user-3","relation":"viewer","object":"document:document-3"}]}
+							}`
+							cmd := exec.Command("curl", "-X", "POST", "-H", "Content-Type: application/json", "-d", jsonBody, "http://localhost:8080/stores/"+storeID+"/write")
+							if err := cmd.Run(); err != nil {
+								t.Fatal(err)
+							}
+						},
+						Config: testAccRelationshipTupleResourceRefreshConfig(providerOptions),
+						ConfigPlanChecks: resource.ConfigPlanChecks{
+							PreApply: []plancheck.PlanCheck{
+								plancheck.ExpectResourceAction(
+									"openfga_relationship_tuple.test[0]",
+									plancheck.ResourceActionCreate,
+								),
+								plancheck.ExpectResourceAction(
+									"openfga_relationship_tuple.test[1]",
+									plancheck.ResourceActionNoop,
+								),
+							},
+						},
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestAccRelationshipTupleResourceWriteBatching(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid window testing
+			{
+				Config:      testAccRelationshipTupleResourceWriteBatchingConfig("invalid", 10),
+				ExpectError: regexp.MustCompile("Invalid Write Batching Window"),
+			},
+			// Batched create testing
+			{
+				Config: testAccRelationshipTupleResourceWriteBatchingConfig("100ms", 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openfga_relationship_tuple.test.0", "user", "user:user-1"),
+					resource.TestCheckResourceAttr("openfga_relationship_tuple.test.9", "user", "user:user-10"),
+					resource.TestCheckResourceAttr("data.openfga_relationship_tuples.test", "relationship_tuples.#", "10"),
+				),
+			},
+			// Batched delete testing
+			{
+				Config: testAccRelationshipTupleResourceWriteBatchingConfig("100ms", 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.openfga_relationship_tuples.test", "relationship_tuples.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRelationshipTupleResourceWriteBatchingConfig(window string, count int) string {
+	return fmt.Sprintf(`
+provider "openfga" {
+	api_url = %[1]q
+
+	write_batching = {
+		window   = %[2]q
+		max_size = 4
+	}
+}
+
+resource "openfga_store" "test" {
+	name = "test"
+}
+
+data "openfga_authorization_model_document" "test" {
+	dsl = <<EOT
+model
+	schema 1.1
+
+type user
+
+type document
+	relations
+		define viewer: [user]
+	EOT
+}
+
+resource "openfga_authorization_model" "test" {
+	store_id = openfga_store.test.id
+
+	model_json = data.openfga_authorization_model_document.test.result
+}
+
+resource "openfga_relationship_tuple" "test" {
+	count = %[3]d
+
+	store_id               = openfga_store.test.id
+	authorization_model_id = openfga_authorization_model.test.id
+
+	user     = "user:user-${count.index + 1}"
+	relation = "viewer"
+	object   = "document:document-${count.index + 1}"
+}
+
+data "openfga_relationship_tuples" "test" {
+	store_id = openfga_store.test.id
+
+	depends_on = [openfga_relationship_tuple.test]
+}
+`, acceptance.ProviderApiUrl, window, count)
+}
+
+func testAccRelationshipTupleResourceRefreshConfig(providerOptions string) string {
+	return fmt.Sprintf(`
+provider "openfga" {
+	api_url = %[1]q
+
+	%[2]s
+}
+
+resource "openfga_store" "test" {
+	name = "test"
+}
+
+data "openfga_authorization_model_document" "test" {
+	dsl = <<EOT
+model
+	schema 1.1
+
+type user
+
+type document
+	relations
+		define viewer: [user]
+	EOT
+}
+
+resource "openfga_authorization_model" "test" {
+	store_id = openfga_store.test.id
+
+	model_json = data.openfga_authorization_model_document.test.result
+}
+
+resource "openfga_relationship_tuple" "test" {
+	count = 2
+
+	store_id               = openfga_store.test.id
+	authorization_model_id = openfga_authorization_model.test.id
+
+	user     = "user:user-${count.index + 1}"
+	relation = "viewer"
+	object   = "document:document-${count.index + 1}"
+}
+`, acceptance.ProviderApiUrl, providerOptions)
+}
+
 func testAccRelationshipTupleResourceConfig(userName string) string {
 	return fmt.Sprintf(`
 %[1]s
@@ -0,0 +1,245 @@
+package relationshiptuple
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	openfga "github.com/openfga/go-sdk"
+	"github.com/openfga/go-sdk/client"
+)
+
+// RelationshipTupleScanner iterates over the relationship tuples of a store
+// page by page, so that only a single page is held in memory at a time.
+//
+//	scanner := wrapper.ScanRelationshipTuples(ctx, storeId, query, consistency, ScanOptions{})
+//	for scanner.Next() {
+//		tuple := scanner.Tuple()
+//	}
+//	if err := scanner.Err(); err != nil {
+//	}
+type RelationshipTupleScanner struct {
+	ctx     context.Context
+	client  *client.OpenFgaClient
+	options client.ClientReadOptions
+	body    client.ClientReadRequest
+
+	pageSize   int32
+	maxResults int
+	startAfter *RelationshipTupleModel
+	filter     func(RelationshipTupleWithConditionModel) bool
+
+	// pageToken is the continuation token the current page was read with, and
+	// skip the number of tuples still to skip from the position of the
+	// continuation token the scan started with.
+	pageToken string
+	skip      int
+
+	page     []openfga.Tuple
+	index    int
+	current  RelationshipTupleWithConditionModel
+	returned int
+	lastPage bool
+	err      error
+}
+
+// scanToken is the position of a scan which stopped within a page, as the
+// number of tuples to skip after a continuation token of the server.
+type scanToken struct {
+	ContinuationToken string `json:"continuation_token"`
+	Skip              int    `json:"skip"`
+}
+
+func encodeScanToken(position scanToken) string {
+	if position.Skip == 0 {
+		return position.ContinuationToken
+	}
+
+	token, _ := json.Marshal(position)
+
+	return base64.RawURLEncoding.EncodeToString(token)
+}
+
+// decodeScanToken decodes a token of encodeScanToken, where any other token is
+// a continuation token of the server.
+func decodeScanToken(token string) scanToken {
+	position := scanToken{}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || json.Unmarshal(decoded, &position) != nil || position.Skip <= 0 {
+		return scanToken{ContinuationToken: token}
+	}
+
+	return position
+}
+
+// maxPageSize is the largest page size accepted by the Read and ReadChanges
+// APIs.
+const maxPageSize = 100
+
+// limitPageSize returns the page size to request, which never exceeds the
+// remaining results or the largest page size accepted by the server. A
+// remaining count of zero means that the results are not limited. Nil applies
+// the default page size of the server.
+func limitPageSize(pageSize int32, remaining int) *int32 {
+	if remaining > 0 && (pageSize <= 0 || int(pageSize) > remaining) {
+		pageSize = int32(min(remaining, maxPageSize))
+	}
+
+	if pageSize <= 0 {
+		return nil
+	}
+
+	return openfga.PtrInt32(min(pageSize, maxPageSize))
+}
+
+// ScanOptions control the pages and the range of a scan over relationship
+// tuples. Zero values use the defaults of the server and scan all tuples.
+type ScanOptions struct {
+	// PageSize is the number of tuples requested per page.
+	PageSize int32
+
+	// MaxResults stops the scan after this many tuples.
+	MaxResults int
+
+	// ContinuationToken starts the scan after a previous scan.
+	ContinuationToken string
+
+	// StartAfter skips all tuples up to and including this tuple, in the order
+	// in which the server returns them. If the tuple does not exist, the scan
+	// fails.
+	StartAfter *RelationshipTupleModel
+
+	// Filter skips all tuples for which it returns false. Skipped tuples do
+	// not count towards MaxResults.
+	Filter func(RelationshipTupleWithConditionModel) bool
+}
+
+// ScanRelationshipTuples starts a scan over the relationship tuples matching
+// the query, or all relationship tuples of the store if the query is nil.
+func (wrapper *RelationshipTupleClient) ScanRelationshipTuples(ctx context.Context, storeId string, query *RelationshipTupleModel, consistency string, scanOptions ScanOptions) *RelationshipTupleScanner {
+	position := decodeScanToken(scanOptions.ContinuationToken)
+
+	options := client.ClientReadOptions{
+		StoreId:           openfga.PtrString(storeId),
+		ContinuationToken: openfga.PtrString(position.ContinuationToken),
+		Consistency:       ResolveConsistency(consistency, wrapper.consistency),
+	}
+
+	body := client.ClientReadRequest{}
+	if query != nil {
+		body = *query.ToReadRequest()
+	}
+
+	return &RelationshipTupleScanner{
+		ctx:        ctx,
+		client:     wrapper.client,
+		options:    options,
+		body:       body,
+		pageSize:   scanOptions.PageSize,
+		maxResults: scanOptions.MaxResults,
+		startAfter: scanOptions.StartAfter,
+		filter:     scanOptions.Filter,
+		skip:       position.Skip,
+	}
+}
+
+// Next advances the scan to the next relationship tuple, reading the next
+// page if necessary. It returns false once the scan is complete or failed.
+func (scanner *RelationshipTupleScanner) Next() bool {
+	if scanner.err != nil || (scanner.maxResults > 0 && scanner.returned >= scanner.maxResults) {
+		return false
+	}
+
+	for {
+		for scanner.index < len(scanner.page) {
+			tuple := scanner.page[scanner.index].Key
+			scanner.index++
+
+			if scanner.skip > 0 {
+				scanner.skip--
+				continue
+			}
+
+			model := NewRelationshipTupleWithConditionModelFromTuple(&tuple)
+			if scanner.startAfter != nil {
+				if model.GetUser() == scanner.startAfter.GetUser() && model.GetRelation() == scanner.startAfter.GetRelation() && model.GetObject() == scanner.startAfter.GetObject() {
+					scanner.startAfter = nil
+				}
+				continue
+			}
+
+			if scanner.filter != nil && !scanner.filter(*model) {
+				continue
+			}
+
+			scanner.current = *model
+			scanner.returned++
+
+			return true
+		}
+
+		if scanner.lastPage {
+			if scanner.startAfter != nil {
+				scanner.err = fmt.Errorf("relationship tuple to start after (user=%s, relation=%s, object=%s) does not exist", scanner.startAfter.GetUser(), scanner.startAfter.GetRelation(), scanner.startAfter.GetObject())
+			}
+			return false
+		}
+
+		scanner.readPage()
+		if scanner.err != nil {
+			return false
+		}
+	}
+}
+
+func (scanner *RelationshipTupleScanner) readPage() {
+	// Without tuples skipped on the client, never request more tuples than
+	// remain, so that the scan stops at the end of a page. Otherwise, full
+	// pages are read, as every page might hold only a few matching tuples.
+	remaining := 0
+	if scanner.maxResults > 0 {
+		remaining = scanner.maxResults - scanner.returned
+	}
+	if scanner.filter != nil || scanner.startAfter != nil || scanner.skip > 0 {
+		remaining = 0
+		if scanner.pageSize <= 0 {
+			remaining = maxPageSize
+		}
+	}
+	scanner.options.PageSize = limitPageSize(scanner.pageSize, remaining)
+
+	response, err := scanner.client.Read(scanner.ctx).Options(scanner.options).Body(scanner.body).Execute()
+	if err != nil {
+		scanner.err = err
+		return
+	}
+
+	scanner.pageToken = *scanner.options.ContinuationToken
+	scanner.page = response.Tuples
+	scanner.index = 0
+	scanner.options.ContinuationToken = openfga.PtrString(response.ContinuationToken)
+	scanner.lastPage = response.ContinuationToken == ""
+}
+
+// Tuple returns the current relationship tuple of the scan.
+func (scanner *RelationshipTupleScanner) Tuple() RelationshipTupleWithConditionModel {
+	return scanner.current
+}
+
+// Err returns the error which stopped the scan, if any.
+func (scanner *RelationshipTupleScanner) Err() error {
+	return scanner.err
+}
+
+// ContinuationToken returns the token to continue the scan after the last
+// returned tuple, or an empty string if all tuples were read. If the scan
+// stopped within a page, the token holds the position within the page.
+func (scanner *RelationshipTupleScanner) ContinuationToken() string {
+	if scanner.index < len(scanner.page) {
+		return encodeScanToken(scanToken{ContinuationToken: scanner.pageToken, Skip: scanner.index})
+	}
+
+	return *scanner.options.ContinuationToken
+}
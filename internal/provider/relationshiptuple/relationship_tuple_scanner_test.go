@@ -0,0 +1,190 @@
+package relationshiptuple
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+
+	openfga "github.com/openfga/go-sdk"
+	"github.com/openfga/go-sdk/client"
+)
+
+const testStoreId = "01ARZ3NDEKTSV4RRFFQ69G5FAV"
+
+// testReadServer serves a fixed number of relationship tuples page by page,
+// and rejects page sizes which the OpenFGA server does not accept.
+type testReadServer struct {
+	tuples int
+
+	mutex     sync.Mutex
+	pageSizes []int32
+	tupleKeys []openfga.ReadRequestTupleKey
+}
+
+func (server *testReadServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var body openfga.ReadRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	pageSize := body.GetPageSize()
+	if pageSize == 0 {
+		pageSize = 50
+	}
+
+	server.mutex.Lock()
+	server.pageSizes = append(server.pageSizes, pageSize)
+	server.tupleKeys = append(server.tupleKeys, body.GetTupleKey())
+	server.mutex.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	if pageSize < 1 || pageSize > maxPageSize {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"code":"validation_error","message":"invalid ReadRequest.PageSize"}`))
+		return
+	}
+
+	offset := 0
+	if body.GetContinuationToken() != "" {
+		offset, _ = strconv.Atoi(body.GetContinuationToken())
+	}
+
+	response := openfga.ReadResponse{Tuples: []openfga.Tuple{}}
+	for index := offset; index < min(offset+int(pageSize), server.tuples); index++ {
+		response.Tuples = append(response.Tuples, openfga.Tuple{
+			Key: openfga.TupleKey{
+				User:     fmt.Sprintf("user:%d", index),
+				Relation: "viewer",
+				Object:   "document:1",
+			},
+		})
+	}
+	if next := offset + len(response.Tuples); next < server.tuples {
+		response.ContinuationToken = strconv.Itoa(next)
+	}
+
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+func newTestRelationshipTupleClient(t *testing.T, tuples int) (*RelationshipTupleClient, *testReadServer) {
+	server := &testReadServer{tuples: tuples}
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+
+	fgaClient, err := client.NewSdkClient(&client.ClientConfiguration{
+		ApiUrl: httpServer.URL,
+	})
+	if err != nil {
+		t.Fatalf("unable to create client: %s", err)
+	}
+
+	return NewRelationshipTupleClient(fgaClient, ""), server
+}
+
+func TestScanRelationshipTuples(t *testing.T) {
+	t.Run("limits pages to the page size of the server", func(t *testing.T) {
+		wrapper, server := newTestRelationshipTupleClient(t, 300)
+
+		tuples, continuationToken, err := wrapper.scanRelationshipTuples(context.Background(), testStoreId, nil, "", ScanOptions{MaxResults: 250})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if len(*tuples) != 250 {
+			t.Fatalf("expected 250 tuples, got %d", len(*tuples))
+		}
+
+		if continuationToken != "250" {
+			t.Fatalf("expected the continuation token after the last tuple, got %q", continuationToken)
+		}
+
+		expectedPageSizes := []int32{100, 100, 50}
+		if fmt.Sprint(server.pageSizes) != fmt.Sprint(expectedPageSizes) {
+			t.Fatalf("expected page sizes %v, got %v", expectedPageSizes, server.pageSizes)
+		}
+	})
+
+	t.Run("continues within a page after filtered tuples", func(t *testing.T) {
+		wrapper, server := newTestRelationshipTupleClient(t, 300)
+
+		filter := func(tuple RelationshipTupleWithConditionModel) bool {
+			return tuple.GetUser() != "user:0"
+		}
+
+		tuples, continuationToken, err := wrapper.scanRelationshipTuples(context.Background(), testStoreId, nil, "", ScanOptions{PageSize: 30, MaxResults: 40, Filter: filter})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if len(*tuples) != 40 || (*tuples)[0].GetUser() != "user:1" || (*tuples)[39].GetUser() != "user:40" {
+			t.Fatalf("expected 40 tuples from user:1 to user:40, got %d", len(*tuples))
+		}
+
+		// Filtered scans read full pages
+		expectedPageSizes := []int32{30, 30}
+		if fmt.Sprint(server.pageSizes) != fmt.Sprint(expectedPageSizes) {
+			t.Fatalf("expected page sizes %v, got %v", expectedPageSizes, server.pageSizes)
+		}
+
+		tuples, _, err = wrapper.scanRelationshipTuples(context.Background(), testStoreId, nil, "", ScanOptions{MaxResults: 1, ContinuationToken: continuationToken})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if len(*tuples) != 1 || (*tuples)[0].GetUser() != "user:41" {
+			t.Fatalf("expected to continue with user:41, got %v", *tuples)
+		}
+	})
+
+	t.Run("reads full pages for selective filters", func(t *testing.T) {
+		wrapper, server := newTestRelationshipTupleClient(t, 300)
+
+		filter := func(tuple RelationshipTupleWithConditionModel) bool {
+			return tuple.GetUser() == "user:250"
+		}
+
+		tuples, _, err := wrapper.scanRelationshipTuples(context.Background(), testStoreId, nil, "", ScanOptions{MaxResults: 1, Filter: filter})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if len(*tuples) != 1 {
+			t.Fatalf("expected 1 tuple, got %d", len(*tuples))
+		}
+
+		if len(server.pageSizes) != 3 {
+			t.Fatalf("expected 3 requests, got %d", len(server.pageSizes))
+		}
+	})
+
+	t.Run("starts after a tuple", func(t *testing.T) {
+		wrapper, _ := newTestRelationshipTupleClient(t, 300)
+
+		startAfter := NewRelationshipTupleModel("user:120", "viewer", "document:1")
+
+		tuples, _, err := wrapper.scanRelationshipTuples(context.Background(), testStoreId, nil, "", ScanOptions{MaxResults: 1, StartAfter: startAfter})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if len(*tuples) != 1 || (*tuples)[0].GetUser() != "user:121" {
+			t.Fatalf("expected to start with user:121, got %v", *tuples)
+		}
+	})
+
+	t.Run("fails if the tuple to start after does not exist", func(t *testing.T) {
+		wrapper, _ := newTestRelationshipTupleClient(t, 300)
+
+		startAfter := NewRelationshipTupleModel("user:missing", "viewer", "document:1")
+
+		if _, _, err := wrapper.scanRelationshipTuples(context.Background(), testStoreId, nil, "", ScanOptions{MaxResults: 1, StartAfter: startAfter}); err == nil {
+			t.Fatalf("expected an error")
+		}
+	})
+}
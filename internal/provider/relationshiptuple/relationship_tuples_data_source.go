@@ -5,11 +5,14 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
-	"github.com/openfga/go-sdk/client"
+	"github.com/openfga/terraform-provider-openfga/internal/provider/providerdata"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -25,9 +28,12 @@ type RelationshipTuplesDataSource struct {
 }
 
 type RelationshipTuplesDataSourceModel struct {
-	StoreId            types.String                          `tfsdk:"store_id"`
-	Query              *RelationshipTupleModel               `tfsdk:"query"`
-	RelationshipTuples []RelationshipTupleWithConditionModel `tfsdk:"relationship_tuples"`
+	StoreId types.String                  `tfsdk:"store_id"`
+	Query   *RelationshipTuplesQueryModel `tfsdk:"query"`
+	ConsistencyModel
+	RelationshipTuplesPageModel
+	RelationshipTuples    []RelationshipTupleWithConditionModel `tfsdk:"relationship_tuples"`
+	NextContinuationToken types.String                          `tfsdk:"next_continuation_token"`
 }
 
 func (d *RelationshipTuplesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -44,9 +50,13 @@ func (d *RelationshipTuplesDataSource) Schema(ctx context.Context, req datasourc
 				Required:            true,
 			},
 			"query": schema.SingleNestedAttribute{
-				MarkdownDescription: "A query to filter the returned relationship tuples. Can be left blank to retrieve all relationship tuples.",
+				MarkdownDescription: "A query to filter the returned relationship tuples. Can be left blank to retrieve all relationship tuples. Filters supported by the OpenFGA server are applied by it, i.e. `object`, or `object_type` together with `user`, as well as `user` and `relation` alongside them. All other filters are applied to the read relationship tuples, which are then read in full pages. If `user` is given without an object type, the relationship tuples of every type of the latest authorization model of the store are read.",
 				Optional:            true,
 				Attributes: map[string]schema.Attribute{
+					"user_type": schema.StringAttribute{
+						MarkdownDescription: "The type of the user of the resulting relationship tuples, e.g. `user` or `group`.",
+						Optional:            true,
+					},
 					"user": schema.StringAttribute{
 						MarkdownDescription: "The user of the resulting relationship tuples.",
 						Optional:            true,
@@ -55,12 +65,71 @@ func (d *RelationshipTuplesDataSource) Schema(ctx context.Context, req datasourc
 						MarkdownDescription: "The relation of the resulting relationship tuples.",
 						Optional:            true,
 					},
+					"object_type": schema.StringAttribute{
+						MarkdownDescription: "The type of the object of the resulting relationship tuples, e.g. `document`.",
+						Optional:            true,
+					},
+					"object": schema.StringAttribute{
+						MarkdownDescription: "The object of the resulting relationship tuples. Either a full object like `document:1`, or only a type like `document:`.",
+						Optional:            true,
+					},
+					"condition_name": schema.StringAttribute{
+						MarkdownDescription: "The name of the condition of the resulting relationship tuples.",
+						Optional:            true,
+					},
+					"has_condition": schema.BoolAttribute{
+						MarkdownDescription: "Whether the resulting relationship tuples have a condition.",
+						Optional:            true,
+					},
+				},
+			},
+			"consistency": schema.StringAttribute{
+				MarkdownDescription: "The consistency preference of the read, either `MINIMIZE_LATENCY` or `HIGHER_CONSISTENCY`. Defaults to the consistency preference of the provider.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(ConsistencyValues...),
+				},
+			},
+			"page_size": schema.Int64Attribute{
+				MarkdownDescription: "The number of relationship tuples read per request, between `1` and `100`. Defaults to the page size of the OpenFGA server.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+			"max_results": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of relationship tuples returned. If more relationship tuples exist, `next_continuation_token` can be used to continue reading them. Defaults to all relationship tuples.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"continuation_token": schema.StringAttribute{
+				MarkdownDescription: "Continue reading relationship tuples after a previous read, using its `next_continuation_token`.",
+				Optional:            true,
+			},
+			"start_after": schema.SingleNestedAttribute{
+				MarkdownDescription: "Skip all relationship tuples up to and including this relationship tuple, in the order in which the OpenFGA server returns them. If the relationship tuple does not exist, an error is returned.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"user": schema.StringAttribute{
+						MarkdownDescription: "The user of the relationship tuple.",
+						Required:            true,
+					},
+					"relation": schema.StringAttribute{
+						MarkdownDescription: "The relation of the relationship tuple.",
+						Required:            true,
+					},
 					"object": schema.StringAttribute{
-						MarkdownDescription: "The object of the resulting relationship tuples.",
+						MarkdownDescription: "The object of the relationship tuple.",
 						Required:            true,
 					},
 				},
 			},
+			"next_continuation_token": schema.StringAttribute{
+				MarkdownDescription: "The token to continue reading relationship tuples after the returned ones. Empty if all relationship tuples were read.",
+				Computed:            true,
+			},
 			"relationship_tuples": schema.ListNestedAttribute{
 				MarkdownDescription: "List of existing relationship tuples in the specific store, matching the query.",
 				Computed:            true,
@@ -106,18 +175,18 @@ func (d *RelationshipTuplesDataSource) Configure(ctx context.Context, req dataso
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.OpenFgaClient)
+	providerData, ok := req.ProviderData.(*providerdata.ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.OpenFgaClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	d.client = NewRelationshipTupleClient(client)
+	d.client = NewRelationshipTupleClient(providerData.Client, providerData.Consistency)
 }
 
 func (d *RelationshipTuplesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -129,13 +198,14 @@ func (d *RelationshipTuplesDataSource) Read(ctx context.Context, req datasource.
 		return
 	}
 
-	relationshipTupleModels, err := d.client.ListRelationshipTuples(ctx, state.StoreId.ValueString(), state.Query)
+	relationshipTupleModels, continuationToken, err := d.client.ListRelationshipTuplesPage(ctx, state.StoreId.ValueString(), state.Query, state.Consistency.ValueString(), state.ToScanOptions())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read relationship tuples, got error: %s", err))
 		return
 	}
 
 	state.RelationshipTuples = *relationshipTupleModels
+	state.NextContinuationToken = types.StringValue(continuationToken)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
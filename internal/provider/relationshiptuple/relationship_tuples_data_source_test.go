@@ -159,6 +159,8 @@ resource "openfga_relationship_tuple" "tuple_%[1]d" {
 
 data "openfga_relationship_tuples" "all" {
 	store_id = openfga_store.test.id
+
+	consistency = "HIGHER_CONSISTENCY"
 }
 
 data "openfga_relationship_tuples" "query" {
@@ -170,3 +172,335 @@ data "openfga_relationship_tuples" "query" {
 }
 `, acceptance.ProviderConfig, resources)
 }
+
+func TestAccRelationshipTuplesDataSourcePagination(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Setup relationship tuples
+			{
+				Config: testAccRelationshipTuplesDataSourcePaginationConfig(),
+			},
+			// Pagination testing
+			{
+				Config: testAccRelationshipTuplesDataSourcePaginationConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.openfga_relationship_tuples.first", "relationship_tuples.#", "2"),
+					resource.TestCheckResourceAttrSet("data.openfga_relationship_tuples.first", "next_continuation_token"),
+					resource.TestCheckResourceAttr("data.openfga_relationship_tuples.second", "relationship_tuples.#", "2"),
+					resource.TestCheckResourceAttr("data.openfga_relationship_tuples.last", "relationship_tuples.#", "1"),
+					resource.TestCheckResourceAttr("data.openfga_relationship_tuples.last", "next_continuation_token", ""),
+					resource.TestCheckResourceAttr("data.openfga_relationship_tuples.start_after", "relationship_tuples.#", "2"),
+					resource.TestCheckResourceAttrPair(
+						"data.openfga_relationship_tuples.start_after", "relationship_tuples.0.object",
+						"data.openfga_relationship_tuples.second", "relationship_tuples.0.object",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.openfga_relationship_tuples.start_after", "relationship_tuples.1.object",
+						"data.openfga_relationship_tuples.second", "relationship_tuples.1.object",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.openfga_relationship_tuples.after_start_after", "relationship_tuples.0.object",
+						"data.openfga_relationship_tuples.last", "relationship_tuples.0.object",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccRelationshipTuplesDataSourcePaginationConfig() string {
+	return acceptance.ProviderConfig + `
+resource "openfga_store" "test" {
+	name = "test"
+}
+
+data "openfga_authorization_model_document" "test" {
+	dsl = <<EOT
+model
+	schema 1.1
+
+type user
+
+type document
+	relations
+		define viewer: [user]
+	EOT
+}
+
+resource "openfga_authorization_model" "test" {
+	store_id = openfga_store.test.id
+
+	model_json = data.openfga_authorization_model_document.test.result
+}
+
+resource "openfga_relationship_tuple" "test" {
+	count = 5
+
+	store_id               = openfga_store.test.id
+	authorization_model_id = openfga_authorization_model.test.id
+
+	user     = "user:user-1"
+	relation = "viewer"
+	object   = "document:document-${count.index + 1}"
+}
+
+data "openfga_relationship_tuples" "first" {
+	store_id = openfga_store.test.id
+
+	page_size   = 1
+	max_results = 2
+
+	depends_on = [openfga_relationship_tuple.test]
+}
+
+data "openfga_relationship_tuples" "second" {
+	store_id = openfga_store.test.id
+
+	max_results        = 2
+	continuation_token = data.openfga_relationship_tuples.first.next_continuation_token
+}
+
+data "openfga_relationship_tuples" "last" {
+	store_id = openfga_store.test.id
+
+	continuation_token = data.openfga_relationship_tuples.second.next_continuation_token
+}
+
+data "openfga_relationship_tuples" "start_after" {
+	store_id = openfga_store.test.id
+
+	max_results = 2
+	start_after = {
+		user     = data.openfga_relationship_tuples.first.relationship_tuples[1].user
+		relation = data.openfga_relationship_tuples.first.relationship_tuples[1].relation
+		object   = data.openfga_relationship_tuples.first.relationship_tuples[1].object
+	}
+}
+
+data "openfga_relationship_tuples" "after_start_after" {
+	store_id = openfga_store.test.id
+
+	continuation_token = data.openfga_relationship_tuples.start_after.next_continuation_token
+}
+`
+}
+
+func TestAccRelationshipTuplesDataSourceFilters(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Setup relationship tuples
+			{
+				Config: testAccRelationshipTuplesDataSourceFiltersConfig(),
+			},
+			// Filter testing
+			{
+				Config: testAccRelationshipTuplesDataSourceFiltersConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.openfga_relationship_tuples.user", "relationship_tuples.#", "2"),
+					resource.TestCheckResourceAttr("data.openfga_relationship_tuples.user_first", "relationship_tuples.#", "1"),
+					resource.TestCheckResourceAttr("data.openfga_relationship_tuples.user_first", "relationship_tuples.0.object", "document:1"),
+					resource.TestCheckResourceAttrSet("data.openfga_relationship_tuples.user_first", "next_continuation_token"),
+					resource.TestCheckResourceAttr("data.openfga_relationship_tuples.user_next", "relationship_tuples.#", "1"),
+					resource.TestCheckResourceAttr("data.openfga_relationship_tuples.user_next", "relationship_tuples.0.object", "folder:1"),
+					resource.TestCheckResourceAttr("data.openfga_relationship_tuples.user_next", "next_continuation_token", ""),
+					resource.TestCheckResourceAttr("data.openfga_relationship_tuples.user_type", "relationship_tuples.#", "1"),
+					resource.TestCheckResourceAttr("data.openfga_relationship_tuples.user_type", "relationship_tuples.0.user", "group:eng#member"),
+					resource.TestCheckResourceAttr("data.openfga_relationship_tuples.object_type", "relationship_tuples.#", "3"),
+					resource.TestCheckResourceAttr("data.openfga_relationship_tuples.type_only_object", "relationship_tuples.#", "1"),
+					resource.TestCheckResourceAttr("data.openfga_relationship_tuples.type_only_object", "relationship_tuples.0.object", "folder:1"),
+					resource.TestCheckResourceAttr("data.openfga_relationship_tuples.user_and_object_type", "relationship_tuples.#", "1"),
+					resource.TestCheckResourceAttr("data.openfga_relationship_tuples.condition_name", "relationship_tuples.#", "1"),
+					resource.TestCheckResourceAttr("data.openfga_relationship_tuples.condition_name", "relationship_tuples.0.object", "document:1"),
+					resource.TestCheckResourceAttr("data.openfga_relationship_tuples.without_condition", "relationship_tuples.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRelationshipTuplesDataSourceFiltersConfig() string {
+	return acceptance.ProviderConfig + `
+resource "openfga_store" "test" {
+	name = "test"
+}
+
+data "openfga_authorization_model_document" "test" {
+	dsl = <<EOT
+model
+	schema 1.1
+
+type user
+
+type group
+	relations
+		define member: [user]
+
+type document
+	relations
+		define viewer: [user, user with non_expired_grant, group#member]
+
+type folder
+	relations
+		define viewer: [user]
+
+condition non_expired_grant(current_time: timestamp, grant_time: timestamp, grant_duration: duration) {
+	current_time < grant_time + grant_duration
+}
+	EOT
+}
+
+resource "openfga_authorization_model" "test" {
+	store_id = openfga_store.test.id
+
+	model_json = data.openfga_authorization_model_document.test.result
+}
+
+resource "openfga_relationship_tuple" "anne_document" {
+	store_id               = openfga_store.test.id
+	authorization_model_id = openfga_authorization_model.test.id
+
+	user     = "user:anne"
+	relation = "viewer"
+	object   = "document:1"
+
+	condition = {
+		name         = "non_expired_grant"
+		context_json = jsonencode({
+			grant_time     = "2023-01-01T00:00:00Z"
+			grant_duration = "10m"
+		})
+	}
+}
+
+resource "openfga_relationship_tuple" "anne_folder" {
+	store_id               = openfga_store.test.id
+	authorization_model_id = openfga_authorization_model.test.id
+
+	user     = "user:anne"
+	relation = "viewer"
+	object   = "folder:1"
+}
+
+resource "openfga_relationship_tuple" "bob_document" {
+	store_id               = openfga_store.test.id
+	authorization_model_id = openfga_authorization_model.test.id
+
+	user     = "user:bob"
+	relation = "viewer"
+	object   = "document:2"
+}
+
+resource "openfga_relationship_tuple" "group_document" {
+	store_id               = openfga_store.test.id
+	authorization_model_id = openfga_authorization_model.test.id
+
+	user     = "group:eng#member"
+	relation = "viewer"
+	object   = "document:3"
+}
+
+locals {
+	tuples = [
+		openfga_relationship_tuple.anne_document,
+		openfga_relationship_tuple.anne_folder,
+		openfga_relationship_tuple.bob_document,
+		openfga_relationship_tuple.group_document,
+	]
+}
+
+data "openfga_relationship_tuples" "user" {
+	store_id = openfga_store.test.id
+
+	query = {
+		user = "user:anne"
+	}
+
+	depends_on = [local.tuples]
+}
+
+data "openfga_relationship_tuples" "user_first" {
+	store_id = openfga_store.test.id
+
+	query = {
+		user = "user:anne"
+	}
+	max_results = 1
+
+	depends_on = [local.tuples]
+}
+
+data "openfga_relationship_tuples" "user_next" {
+	store_id = openfga_store.test.id
+
+	query = {
+		user = "user:anne"
+	}
+	continuation_token = data.openfga_relationship_tuples.user_first.next_continuation_token
+}
+
+data "openfga_relationship_tuples" "user_type" {
+	store_id = openfga_store.test.id
+
+	query = {
+		user_type = "group"
+	}
+
+	depends_on = [local.tuples]
+}
+
+data "openfga_relationship_tuples" "object_type" {
+	store_id = openfga_store.test.id
+
+	query = {
+		object_type = "document"
+	}
+
+	depends_on = [local.tuples]
+}
+
+data "openfga_relationship_tuples" "type_only_object" {
+	store_id = openfga_store.test.id
+
+	query = {
+		object = "folder:"
+	}
+
+	depends_on = [local.tuples]
+}
+
+data "openfga_relationship_tuples" "user_and_object_type" {
+	store_id = openfga_store.test.id
+
+	query = {
+		user        = "user:anne"
+		object_type = "document"
+	}
+
+	depends_on = [local.tuples]
+}
+
+data "openfga_relationship_tuples" "condition_name" {
+	store_id = openfga_store.test.id
+
+	query = {
+		condition_name = "non_expired_grant"
+	}
+
+	depends_on = [local.tuples]
+}
+
+data "openfga_relationship_tuples" "without_condition" {
+	store_id = openfga_store.test.id
+
+	query = {
+		has_condition = false
+	}
+
+	depends_on = [local.tuples]
+}
+`
+}
@@ -0,0 +1,21 @@
+package relationshiptuple
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type RelationshipTuplesPageModel struct {
+	PageSize          types.Int64             `tfsdk:"page_size"`
+	MaxResults        types.Int64             `tfsdk:"max_results"`
+	ContinuationToken types.String            `tfsdk:"continuation_token"`
+	StartAfter        *RelationshipTupleModel `tfsdk:"start_after"`
+}
+
+func (model RelationshipTuplesPageModel) ToScanOptions() ScanOptions {
+	return ScanOptions{
+		PageSize:          int32(model.PageSize.ValueInt64()),
+		MaxResults:        int(model.MaxResults.ValueInt64()),
+		ContinuationToken: model.ContinuationToken.ValueString(),
+		StartAfter:        model.StartAfter,
+	}
+}
@@ -0,0 +1,142 @@
+package relationshiptuple
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	openfga "github.com/openfga/go-sdk"
+)
+
+type RelationshipTuplesQueryModel struct {
+	UserType      types.String `tfsdk:"user_type"`
+	User          types.String `tfsdk:"user"`
+	Relation      types.String `tfsdk:"relation"`
+	ObjectType    types.String `tfsdk:"object_type"`
+	Object        types.String `tfsdk:"object"`
+	ConditionName types.String `tfsdk:"condition_name"`
+	HasCondition  types.Bool   `tfsdk:"has_condition"`
+}
+
+// GetObjectType returns the object type to filter by, which is either set
+// explicitly or derived from the object.
+func (model RelationshipTuplesQueryModel) GetObjectType() string {
+	if !model.ObjectType.IsNull() {
+		return model.ObjectType.ValueString()
+	}
+
+	return typeOf(model.Object.ValueString())
+}
+
+// GetObject returns the object to filter by, or an empty string if the object
+// only consists of a type like `document:`.
+func (model RelationshipTuplesQueryModel) GetObject() string {
+	object := model.Object.ValueString()
+	if strings.HasSuffix(object, ":") {
+		return ""
+	}
+
+	return object
+}
+
+// ToReadQuery returns the part of the query which the Read API supports for
+// the given object type, or nil if all tuples have to be read. The Read API
+// requires an object, which may only consist of a type if a user is given.
+func (model RelationshipTuplesQueryModel) ToReadQuery(objectType string) *RelationshipTupleModel {
+	if object := model.GetObject(); object != "" {
+		return &RelationshipTupleModel{
+			User:     model.User,
+			Relation: model.Relation,
+			Object:   types.StringValue(object),
+		}
+	}
+
+	if objectType == "" || model.User.IsNull() {
+		return nil
+	}
+
+	return &RelationshipTupleModel{
+		User:     model.User,
+		Relation: model.Relation,
+		Object:   types.StringValue(objectType + ":"),
+	}
+}
+
+// RequiresTypeScan returns whether the tuples have to be read per object type,
+// as the query has a user but no object type.
+func (model RelationshipTuplesQueryModel) RequiresTypeScan() bool {
+	return !model.User.IsNull() && model.GetObjectType() == ""
+}
+
+// RequiresFilter returns whether the query has filters which the Read query
+// for the given object type does not apply, so that the read tuples have to
+// be matched against the query.
+func (model RelationshipTuplesQueryModel) RequiresFilter(objectType string) bool {
+	if !model.UserType.IsNull() || !model.ConditionName.IsNull() || !model.HasCondition.IsNull() {
+		return true
+	}
+
+	if model.ToReadQuery(objectType) == nil {
+		return !model.User.IsNull() || !model.Relation.IsNull() || model.GetObjectType() != ""
+	}
+
+	// An explicit object type might contradict the type of the object
+	object := model.GetObject()
+	return object != "" && typeOf(object) != model.GetObjectType()
+}
+
+// Matches returns whether the relationship tuple matches all filters of the
+// query.
+func (model RelationshipTuplesQueryModel) Matches(tuple RelationshipTupleWithConditionModel) bool {
+	if !model.UserType.IsNull() && typeOf(tuple.GetUser()) != model.UserType.ValueString() {
+		return false
+	}
+
+	if !model.User.IsNull() && tuple.GetUser() != model.User.ValueString() {
+		return false
+	}
+
+	if !model.Relation.IsNull() && tuple.GetRelation() != model.Relation.ValueString() {
+		return false
+	}
+
+	if objectType := model.GetObjectType(); objectType != "" && typeOf(tuple.GetObject()) != objectType {
+		return false
+	}
+
+	if object := model.GetObject(); object != "" && tuple.GetObject() != object {
+		return false
+	}
+
+	if !model.ConditionName.IsNull() && (tuple.Condition == nil || tuple.Condition.Name.ValueString() != model.ConditionName.ValueString()) {
+		return false
+	}
+
+	if !model.HasCondition.IsNull() && (tuple.Condition != nil) != model.HasCondition.ValueBool() {
+		return false
+	}
+
+	return true
+}
+
+// typeOf returns the type of a user or object, e.g. `group` for
+// `group:marketing#member`.
+func typeOf(value string) string {
+	objectType, _, _ := strings.Cut(value, ":")
+
+	return objectType
+}
+
+// objectTypesOf returns the types of the authorization model in their order
+// of definition.
+func objectTypesOf(model *openfga.AuthorizationModel) []string {
+	objectTypes := []string{}
+	if model == nil {
+		return objectTypes
+	}
+
+	for _, typeDefinition := range model.TypeDefinitions {
+		objectTypes = append(objectTypes, typeDefinition.Type)
+	}
+
+	return objectTypes
+}
@@ -0,0 +1,256 @@
+package relationshiptuple
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+type TupleFileCondition struct {
+	Name    string                  `json:"name" yaml:"name"`
+	Context *map[string]interface{} `json:"context,omitempty" yaml:"context,omitempty"`
+}
+
+// TupleFileTuple describes a relationship tuple as used in the files of the
+// OpenFGA CLI (e.g. `tuples` of an `.fga.yaml` store file).
+type TupleFileTuple struct {
+	User      string              `json:"user" yaml:"user"`
+	Relation  string              `json:"relation" yaml:"relation"`
+	Object    string              `json:"object" yaml:"object"`
+	Condition *TupleFileCondition `json:"condition,omitempty" yaml:"condition,omitempty"`
+}
+
+func (tuple TupleFileTuple) ToRelationshipTupleWithConditionModel() *RelationshipTupleWithConditionModel {
+	var condition *RelationshipConditionModel
+	if tuple.Condition != nil && tuple.Condition.Name != "" {
+		condition = NewRelationshipConditionModel(tuple.Condition.Name, tuple.Condition.Context)
+	}
+
+	return NewRelationshipTupleWithConditionModel(tuple.User, tuple.Relation, tuple.Object, condition)
+}
+
+func NewRelationshipTupleWithConditionModelsFromTupleFileTuples(tuples []TupleFileTuple) []RelationshipTupleWithConditionModel {
+	relationshipTupleModels := []RelationshipTupleWithConditionModel{}
+	for _, tuple := range tuples {
+		relationshipTupleModels = append(relationshipTupleModels, *tuple.ToRelationshipTupleWithConditionModel())
+	}
+
+	return relationshipTupleModels
+}
+
+// TupleFileFormats are the supported formats of tuple files, which are the
+// formats supported by the OpenFGA CLI.
+var TupleFileFormats = []string{"csv", "json", "jsonl", "yaml"}
+
+// tupleFileColumns are the columns of a CSV tuple file. The user relation and
+// the condition columns are optional.
+var tupleFileColumns = []string{"user_type", "user_id", "user_relation", "relation", "object_type", "object_id", "condition_name", "condition_context"}
+
+// ParseTupleFile reads the relationship tuples of a tuple file. The format
+// is determined by the file extension.
+func ParseTupleFile(tupleFilePath string) ([]TupleFileTuple, error) {
+	return ParseTupleFileWithFormat(tupleFilePath, "")
+}
+
+// ParseTupleFileWithFormat reads the relationship tuples of a tuple file in
+// the given format, or determines the format by the file extension if empty.
+func ParseTupleFileWithFormat(tupleFilePath string, format string) ([]TupleFileTuple, error) {
+	tupleFileBytes, err := os.ReadFile(tupleFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read tuple file, got error: %s", err)
+	}
+
+	if format == "" {
+		format, err = tupleFileFormatOf(tupleFilePath)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tuples := []TupleFileTuple{}
+
+	switch format {
+	case "csv":
+		tuples, err = parseCsvTuples(tupleFileBytes)
+	case "json":
+		err = json.Unmarshal(tupleFileBytes, &tuples)
+	case "jsonl":
+		tuples, err = parseJsonlTuples(tupleFileBytes)
+	case "yaml":
+		err = yaml.Unmarshal(tupleFileBytes, &tuples)
+	default:
+		return nil, fmt.Errorf("unsupported tuple file format %q", format)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse tuple file %q, got error: %s", tupleFilePath, err)
+	}
+
+	return tuples, nil
+}
+
+func tupleFileFormatOf(tupleFilePath string) (string, error) {
+	switch filepath.Ext(tupleFilePath) {
+	case ".csv":
+		return "csv", nil
+	case ".json":
+		return "json", nil
+	case ".jsonl":
+		return "jsonl", nil
+	case ".yaml", ".yml":
+		return "yaml", nil
+	default:
+		return "", fmt.Errorf("unsupported tuple file format %q", filepath.Ext(tupleFilePath))
+	}
+}
+
+func parseJsonlTuples(tupleFileBytes []byte) ([]TupleFileTuple, error) {
+	tuples := []TupleFileTuple{}
+
+	for index, line := range strings.Split(string(tupleFileBytes), "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		tuple := TupleFileTuple{}
+		if err := json.Unmarshal([]byte(line), &tuple); err != nil {
+			return nil, fmt.Errorf("line %d: %s", index+1, err)
+		}
+
+		tuples = append(tuples, tuple)
+	}
+
+	return tuples, nil
+}
+
+func parseCsvTuples(tupleFileBytes []byte) ([]TupleFileTuple, error) {
+	records, err := csv.NewReader(bytes.NewReader(tupleFileBytes)).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(records) == 0 {
+		return nil, fmt.Errorf("missing header")
+	}
+
+	columns := map[string]int{}
+	for index, column := range records[0] {
+		column = strings.TrimSpace(column)
+		if !slices.Contains(tupleFileColumns, column) {
+			return nil, fmt.Errorf("unknown column %q", column)
+		}
+		columns[column] = index
+	}
+
+	for _, column := range []string{"user_type", "user_id", "relation", "object_type", "object_id"} {
+		if _, ok := columns[column]; !ok {
+			return nil, fmt.Errorf("missing column %q", column)
+		}
+	}
+
+	tuples := []TupleFileTuple{}
+	for index, record := range records[1:] {
+		value := func(column string) string {
+			columnIndex, ok := columns[column]
+			if !ok {
+				return ""
+			}
+
+			return strings.TrimSpace(record[columnIndex])
+		}
+
+		tuple := TupleFileTuple{
+			User:     value("user_type") + ":" + value("user_id"),
+			Relation: value("relation"),
+			Object:   value("object_type") + ":" + value("object_id"),
+		}
+
+		if userRelation := value("user_relation"); userRelation != "" {
+			tuple.User += "#" + userRelation
+		}
+
+		if conditionName := value("condition_name"); conditionName != "" {
+			tuple.Condition = &TupleFileCondition{Name: conditionName}
+
+			if conditionContext := value("condition_context"); conditionContext != "" {
+				values := map[string]interface{}{}
+				if err := json.Unmarshal([]byte(conditionContext), &values); err != nil {
+					// The header is the first line of the file.
+					return nil, fmt.Errorf("line %d: invalid condition context: %s", index+2, err)
+				}
+				tuple.Condition.Context = &values
+			}
+		} else if value("condition_context") != "" {
+			return nil, fmt.Errorf("line %d: condition context without condition name", index+2)
+		}
+
+		tuples = append(tuples, tuple)
+	}
+
+	return tuples, nil
+}
+
+// ValidateTupleFileTuples checks that all tuples are complete, and removes
+// duplicate tuples. Duplicates with different conditions are an error, as
+// only one of them can be written.
+func ValidateTupleFileTuples(tuples []TupleFileTuple) ([]TupleFileTuple, error) {
+	uniqueTuples := []TupleFileTuple{}
+	seen := map[string]TupleFileTuple{}
+
+	for index, tuple := range tuples {
+		if !isTupleFileEntity(tuple.User) || tuple.Relation == "" || !isTupleFileEntity(tuple.Object) {
+			return nil, fmt.Errorf("tuple %d (user=%s, relation=%s, object=%s) is incomplete", index+1, tuple.User, tuple.Relation, tuple.Object)
+		}
+
+		if tuple.Condition != nil && tuple.Condition.Name == "" {
+			return nil, fmt.Errorf("tuple %d (user=%s, relation=%s, object=%s) has a condition without a name", index+1, tuple.User, tuple.Relation, tuple.Object)
+		}
+
+		key := tuple.User + " " + tuple.Relation + " " + tuple.Object
+		if previous, ok := seen[key]; ok {
+			if !reflect.DeepEqual(normalizeTupleFileCondition(previous.Condition), normalizeTupleFileCondition(tuple.Condition)) {
+				return nil, fmt.Errorf("tuple %d (user=%s, relation=%s, object=%s) is duplicated with a different condition", index+1, tuple.User, tuple.Relation, tuple.Object)
+			}
+			continue
+		}
+
+		seen[key] = tuple
+		uniqueTuples = append(uniqueTuples, tuple)
+	}
+
+	return uniqueTuples, nil
+}
+
+// isTupleFileEntity returns whether the value is a user or object consisting
+// of a type and an ID.
+// normalizeTupleFileCondition converts the context of the condition into its
+// JSON representation, so that conditions from different file formats can be
+// compared, e.g. integers of YAML with numbers of JSON. A missing context
+// equals an empty one.
+func normalizeTupleFileCondition(condition *TupleFileCondition) *TupleFileCondition {
+	if condition == nil {
+		return nil
+	}
+
+	values := map[string]interface{}{}
+	if condition.Context != nil {
+		if encoded, err := json.Marshal(*condition.Context); err == nil {
+			_ = json.Unmarshal(encoded, &values)
+		}
+	}
+
+	return &TupleFileCondition{Name: condition.Name, Context: &values}
+}
+
+func isTupleFileEntity(value string) bool {
+	entityType, id, ok := strings.Cut(value, ":")
+
+	return ok && entityType != "" && id != ""
+}
@@ -0,0 +1,133 @@
+package relationshiptuple
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TupleFileDataSource{}
+var _ datasource.DataSourceWithConfigure = &TupleFileDataSource{}
+
+func NewTupleFileDataSource() datasource.DataSource {
+	return &TupleFileDataSource{}
+}
+
+type TupleFileDataSource struct{}
+
+type TupleFileDataSourceModel struct {
+	Path   types.String `tfsdk:"path"`
+	Format types.String `tfsdk:"format"`
+
+	RelationshipTuples []RelationshipTupleWithConditionModel `tfsdk:"relationship_tuples"`
+}
+
+func (d *TupleFileDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tuple_file"
+}
+
+func (d *TupleFileDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Parses a local tuple file in one of the formats of the OpenFGA CLI into a list of relationship tuples, e.g. to create them with ` + "`openfga_relationship_tuple`" + ` resources.
+
+The following formats are supported:
+
+- CSV with the columns ` + "`user_type`, `user_id`, `user_relation`, `relation`, `object_type`, `object_id`, `condition_name` and `condition_context`" + `, of which ` + "`user_relation`" + ` and the condition columns are optional.
+- JSON with an array of relationship tuples.
+- JSONL with a relationship tuple per line.
+- YAML with a list of relationship tuples.
+
+The relationship tuples are validated, and duplicates are removed.
+`,
+
+		Attributes: map[string]schema.Attribute{
+			"path": schema.StringAttribute{
+				MarkdownDescription: "The path of the tuple file.",
+				Required:            true,
+			},
+			"format": schema.StringAttribute{
+				MarkdownDescription: "The format of the tuple file, one of `csv`, `json`, `jsonl` or `yaml`. Defaults to the format of the file extension.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(TupleFileFormats...),
+				},
+			},
+			"relationship_tuples": schema.ListNestedAttribute{
+				MarkdownDescription: "The relationship tuples of the tuple file, in the order of the file.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"user": schema.StringAttribute{
+							MarkdownDescription: "The user of the relationship tuple.",
+							Computed:            true,
+						},
+						"relation": schema.StringAttribute{
+							MarkdownDescription: "The relation of the relationship tuple.",
+							Computed:            true,
+						},
+						"object": schema.StringAttribute{
+							MarkdownDescription: "The object of the relationship tuple.",
+							Computed:            true,
+						},
+						"condition": schema.SingleNestedAttribute{
+							MarkdownDescription: "A condition of the relationship tuple.",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									MarkdownDescription: "The name of the condition.",
+									Computed:            true,
+								},
+								"context_json": schema.StringAttribute{
+									MarkdownDescription: "The (partial) context under which the condition is evaluated.",
+									CustomType:          jsontypes.NormalizedType{},
+									Computed:            true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TupleFileDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+}
+
+func (d *TupleFileDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state TupleFileDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tuples, err := ParseTupleFileWithFormat(state.Path.ValueString(), state.Format.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Input Error", fmt.Sprintf("Unable to parse tuple file, got error: %s", err))
+		return
+	}
+
+	tuples, err = ValidateTupleFileTuples(tuples)
+	if err != nil {
+		resp.Diagnostics.AddError("Input Error", fmt.Sprintf("Invalid tuple file %q, got error: %s", state.Path.ValueString(), err))
+		return
+	}
+
+	state.RelationshipTuples = NewRelationshipTupleWithConditionModelsFromTupleFileTuples(tuples)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,83 @@
+package relationshiptuple_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/openfga/terraform-provider-openfga/internal/provider/acceptance"
+)
+
+func TestAccTupleFileDataSource(t *testing.T) {
+	for _, fileName := range []string{"tuples.csv", "tuples.json", "tuples.jsonl", "tuples.yaml"} {
+		t.Run(fileName, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+				ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					// Read testing
+					{
+						Config: testAccTupleFileDataSourceConfig(fileName),
+						ConfigStateChecks: []statecheck.StateCheck{
+							statecheck.ExpectKnownValue(
+								"data.openfga_tuple_file.test",
+								tfjsonpath.New("relationship_tuples"),
+								knownvalue.ListExact([]knownvalue.Check{
+									knownvalue.ObjectExact(map[string]knownvalue.Check{
+										"user":      knownvalue.StringExact("user:anne"),
+										"relation":  knownvalue.StringExact("viewer"),
+										"object":    knownvalue.StringExact("document:1"),
+										"condition": knownvalue.Null(),
+									}),
+									knownvalue.ObjectExact(map[string]knownvalue.Check{
+										"user":      knownvalue.StringExact("group:eng#member"),
+										"relation":  knownvalue.StringExact("viewer"),
+										"object":    knownvalue.StringExact("document:2"),
+										"condition": knownvalue.Null(),
+									}),
+									knownvalue.ObjectExact(map[string]knownvalue.Check{
+										"user":     knownvalue.StringExact("user:bob"),
+										"relation": knownvalue.StringExact("viewer"),
+										"object":   knownvalue.StringExact("document:3"),
+										"condition": knownvalue.ObjectExact(map[string]knownvalue.Check{
+											"name":         knownvalue.StringExact("larger_than"),
+											"context_json": knownvalue.StringExact(`{"required":10}`),
+										}),
+									}),
+								}),
+							),
+						},
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestAccTupleFileDataSourceInvalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTupleFileDataSourceConfig("conflicting.yaml"),
+				ExpectError: regexp.MustCompile(`is duplicated\s+with a different condition`),
+			},
+		},
+	})
+}
+
+func testAccTupleFileDataSourceConfig(fileName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "openfga_tuple_file" "test" {
+	path = "${path.root}/../acceptance/tuplefile/%[2]s"
+}
+`, acceptance.ProviderConfig, fileName)
+}
@@ -0,0 +1,93 @@
+package relationshiptuple
+
+import (
+	"encoding/json"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestValidateTupleFileTuples(t *testing.T) {
+	parse := func(t *testing.T, yamlTuples string, jsonTuples string) []TupleFileTuple {
+		tuples := []TupleFileTuple{}
+		if err := yaml.Unmarshal([]byte(yamlTuples), &tuples); err != nil {
+			t.Fatalf("unable to parse YAML tuples: %s", err)
+		}
+
+		jsonTupleList := []TupleFileTuple{}
+		if err := json.Unmarshal([]byte(jsonTuples), &jsonTupleList); err != nil {
+			t.Fatalf("unable to parse JSON tuples: %s", err)
+		}
+
+		return append(tuples, jsonTupleList...)
+	}
+
+	t.Run("removes duplicates with numbers of YAML and JSON", func(t *testing.T) {
+		tuples := parse(t, `
+- user: user:anne
+  relation: viewer
+  object: document:1
+  condition:
+    name: larger_than
+    context:
+      required: 10
+      limits: { max: 20 }
+`, `[{"user":"user:anne","relation":"viewer","object":"document:1","condition":{"name":"larger_than","context":{"required":10.0,"limits":{"max":20}}}}]`)
+
+		unique, err := ValidateTupleFileTuples(tuples)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if len(unique) != 1 {
+			t.Fatalf("expected 1 tuple, got %d", len(unique))
+		}
+	})
+
+	t.Run("removes duplicates with a missing and an empty context", func(t *testing.T) {
+		tuples := parse(t, `
+- user: user:anne
+  relation: viewer
+  object: document:1
+  condition:
+    name: in_region
+`, `[{"user":"user:anne","relation":"viewer","object":"document:1","condition":{"name":"in_region","context":{}}}]`)
+
+		unique, err := ValidateTupleFileTuples(tuples)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if len(unique) != 1 {
+			t.Fatalf("expected 1 tuple, got %d", len(unique))
+		}
+	})
+
+	t.Run("fails for duplicates with a different condition", func(t *testing.T) {
+		tuples := parse(t, `
+- user: user:anne
+  relation: viewer
+  object: document:1
+  condition:
+    name: larger_than
+    context:
+      required: 10
+`, `[{"user":"user:anne","relation":"viewer","object":"document:1","condition":{"name":"larger_than","context":{"required":11}}}]`)
+
+		if _, err := ValidateTupleFileTuples(tuples); err == nil {
+			t.Fatalf("expected an error")
+		}
+	})
+
+	t.Run("fails for duplicates with and without a condition", func(t *testing.T) {
+		tuples := parse(t, `
+- user: user:anne
+  relation: viewer
+  object: document:1
+`, `[{"user":"user:anne","relation":"viewer","object":"document:1","condition":{"name":"in_region"}}]`)
+
+		if _, err := ValidateTupleFileTuples(tuples); err == nil {
+			t.Fatalf("expected an error")
+		}
+	})
+}
@@ -0,0 +1,190 @@
+package storefile
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	openfga "github.com/openfga/go-sdk"
+	"github.com/openfga/go-sdk/client"
+
+	"github.com/openfga/terraform-provider-openfga/internal/provider/authorizationmodel"
+	"github.com/openfga/terraform-provider-openfga/internal/provider/query"
+	"github.com/openfga/terraform-provider-openfga/internal/provider/relationshiptuple"
+	"github.com/openfga/terraform-provider-openfga/internal/provider/store"
+)
+
+type ModelTestClient struct {
+	storeClient              *store.StoreClient
+	authorizationModelClient *authorizationmodel.AuthorizationModelClient
+	relationshipTupleClient  *relationshiptuple.RelationshipTupleClient
+	queryClient              *query.QueryClient
+}
+
+func NewModelTestClient(client *client.OpenFgaClient) *ModelTestClient {
+	// The queries of a test run right after its tuples are written, so they
+	// always use higher consistency.
+	consistency := openfga.CONSISTENCYPREFERENCE_HIGHER_CONSISTENCY
+
+	return &ModelTestClient{
+		storeClient:              store.NewStoreClient(client),
+		authorizationModelClient: authorizationmodel.NewAuthorizationModelClient(client),
+		relationshipTupleClient:  relationshiptuple.NewRelationshipTupleClient(client, consistency),
+		queryClient:              query.NewQueryClient(client, consistency, nil),
+	}
+}
+
+// RunTest runs a single model test against a temporary store, which is
+// deleted again once the test has finished. Failed assertions are reported
+// in the result, while errors are only returned if the test could not be run.
+func (wrapper *ModelTestClient) RunTest(ctx context.Context, modelJson string, tuples []relationshiptuple.RelationshipTupleWithConditionModel, test ModelTestModel) (*ModelTestResultModel, error) {
+	storeModel, err := wrapper.storeClient.CreateStore(ctx, *store.NewStoreModel("", fmt.Sprintf("model-test-%s", test.Name.ValueString())))
+	if err != nil {
+		return nil, fmt.Errorf("unable to create temporary store, got error: %s", err)
+	}
+
+	defer func() {
+		_ = wrapper.storeClient.DeleteStore(ctx, *storeModel)
+	}()
+
+	storeId := storeModel.GetId()
+
+	authorizationModelModel, err := wrapper.authorizationModelClient.CreateAuthorizationModel(ctx, storeId, *authorizationmodel.NewAuthorizationModelModelWithModelJson("", modelJson))
+	if err != nil {
+		return nil, fmt.Errorf("unable to write authorization model, got error: %s", err)
+	}
+
+	authorizationModelId := authorizationModelModel.GetId()
+
+	err = wrapper.relationshipTupleClient.CreateRelationshipTuples(ctx, storeId, &authorizationModelId, append(append([]relationshiptuple.RelationshipTupleWithConditionModel{}, tuples...), test.GetTuples()...))
+	if err != nil {
+		return nil, fmt.Errorf("unable to write relationship tuples, got error: %s", err)
+	}
+
+	failures := []string{}
+
+	for _, check := range test.GetCheck() {
+		context, err := check.GetContextMap()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, relation := range sortedKeys(check.Assertions) {
+			expectation := check.Assertions[relation].ValueBool()
+
+			queryModel := query.NewCheckQueryModel(check.User.ValueString(), relation, check.Object.ValueString(), nil, context)
+
+			result, err := wrapper.queryClient.Check(ctx, storeId, authorizationModelId, *queryModel, "")
+			if err != nil {
+				failures = append(failures, fmt.Sprintf("check(user=%s, relation=%s, object=%s): %s", check.User.ValueString(), relation, check.Object.ValueString(), err))
+				continue
+			}
+
+			if result.ValueBool() != expectation {
+				failures = append(failures, fmt.Sprintf("check(user=%s, relation=%s, object=%s): expected %t, got %t", check.User.ValueString(), relation, check.Object.ValueString(), expectation, result.ValueBool()))
+			}
+		}
+	}
+
+	for _, listObjects := range test.GetListObjects() {
+		context, err := listObjects.GetContextMap()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, relation := range sortedKeys(listObjects.Assertions) {
+			expectation := toStrings(listObjects.Assertions[relation])
+
+			queryModel := query.NewListObjectsQueryModel(listObjects.User.ValueString(), relation, listObjects.Type.ValueString(), nil, context)
+
+			result, err := wrapper.queryClient.ListObjects(ctx, storeId, authorizationModelId, *queryModel, "", 0, 0)
+			if err != nil {
+				failures = append(failures, fmt.Sprintf("list_objects(user=%s, relation=%s, type=%s): %s", listObjects.User.ValueString(), relation, listObjects.Type.ValueString(), err))
+				continue
+			}
+
+			objects := []string{}
+			for _, element := range result.Result.Elements() {
+				objects = append(objects, element.(types.String).ValueString())
+			}
+
+			if !equalSets(objects, expectation) {
+				failures = append(failures, fmt.Sprintf("list_objects(user=%s, relation=%s, type=%s): expected %s, got %s", listObjects.User.ValueString(), relation, listObjects.Type.ValueString(), formatSet(expectation), formatSet(objects)))
+			}
+		}
+	}
+
+	for _, listUsers := range test.GetListUsers() {
+		context, err := listUsers.GetContextMap()
+		if err != nil {
+			return nil, err
+		}
+
+		userFilters := []string{}
+		for _, userFilter := range listUsers.UserFilters {
+			userFilters = append(userFilters, userFilter.String())
+		}
+
+		for _, relation := range sortedKeys(listUsers.Assertions) {
+			expectation := toStrings(listUsers.Assertions[relation])
+
+			queryModel := query.NewListUsersQueryModel(listUsers.UserFilters, relation, listUsers.Object.ValueString(), nil, context)
+
+			result, err := wrapper.queryClient.ListUsers(ctx, storeId, authorizationModelId, *queryModel, "")
+			if err != nil {
+				failures = append(failures, fmt.Sprintf("list_users(object=%s, relation=%s, user_filters=%s): %s", listUsers.Object.ValueString(), relation, formatSet(userFilters), err))
+				continue
+			}
+
+			users := []string{}
+			for _, element := range result.Result.Elements() {
+				users = append(users, element.(types.String).ValueString())
+			}
+
+			if !equalSets(users, expectation) {
+				failures = append(failures, fmt.Sprintf("list_users(object=%s, relation=%s, user_filters=%s): expected %s, got %s", listUsers.Object.ValueString(), relation, formatSet(userFilters), formatSet(expectation), formatSet(users)))
+			}
+		}
+	}
+
+	return &ModelTestResultModel{
+		Name:        test.Name,
+		Description: test.Description,
+		Passed:      types.BoolValue(len(failures) == 0),
+		Failures:    toStringValues(failures),
+	}, nil
+}
+
+func sortedKeys[V any](values map[string]V) []string {
+	keys := []string{}
+	for key := range values {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func toStrings(values []types.String) []string {
+	result := []string{}
+	for _, value := range values {
+		result = append(result, value.ValueString())
+	}
+
+	return result
+}
+
+func equalSets(a []string, b []string) bool {
+	a = slices.Compact(slices.Sorted(slices.Values(a)))
+	b = slices.Compact(slices.Sorted(slices.Values(b)))
+
+	return slices.Equal(a, b)
+}
+
+func formatSet(values []string) string {
+	return fmt.Sprintf("[%s]", strings.Join(slices.Compact(slices.Sorted(slices.Values(values))), ", "))
+}
@@ -0,0 +1,360 @@
+package storefile
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/openfga/terraform-provider-openfga/internal/provider/providerdata"
+	"github.com/openfga/terraform-provider-openfga/internal/provider/relationshiptuple"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ModelTestDataSource{}
+var _ datasource.DataSourceWithConfigure = &ModelTestDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &ModelTestDataSource{}
+
+func NewModelTestDataSource() datasource.DataSource {
+	return &ModelTestDataSource{}
+}
+
+type ModelTestDataSource struct {
+	client *ModelTestClient
+}
+
+type ModelTestDataSourceModel struct {
+	StoreFilePath types.String                                             `tfsdk:"store_file_path"`
+	ModelJson     jsontypes.Normalized                                     `tfsdk:"model_json"`
+	Tuples        *[]relationshiptuple.RelationshipTupleWithConditionModel `tfsdk:"tuples"`
+	Tests         *[]ModelTestModel                                        `tfsdk:"tests"`
+
+	Passed  types.Bool             `tfsdk:"passed"`
+	Results []ModelTestResultModel `tfsdk:"results"`
+}
+
+func (d *ModelTestDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_model_test"
+}
+
+func relationshipTupleSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"user": schema.StringAttribute{
+			MarkdownDescription: "The user of the relationship tuple.",
+			Required:            true,
+		},
+		"relation": schema.StringAttribute{
+			MarkdownDescription: "The relation of the relationship tuple.",
+			Required:            true,
+		},
+		"object": schema.StringAttribute{
+			MarkdownDescription: "The object of the relationship tuple.",
+			Required:            true,
+		},
+		"condition": schema.SingleNestedAttribute{
+			MarkdownDescription: "A condition of the relationship tuple.",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					MarkdownDescription: "The name of the condition.",
+					Required:            true,
+				},
+				"context_json": schema.StringAttribute{
+					MarkdownDescription: "The (partial) context under which the condition is evaluated.",
+					CustomType:          jsontypes.NormalizedType{},
+					Optional:            true,
+				},
+			},
+		},
+	}
+}
+
+func (d *ModelTestDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Runs the tests of an authorization model, as known from the ` + "`.fga.yaml`" + ` store files of the OpenFGA CLI.
+
+Every test is run against a temporary store, which contains the authorization model together with the global and the test specific relationship tuples. The store is deleted once the test has finished. If any assertion of a test fails, an error is raised.
+`,
+
+		Attributes: map[string]schema.Attribute{
+			"store_file_path": schema.StringAttribute{
+				MarkdownDescription: "The path to an `.fga.yaml` store file containing the model, tuples and tests. Conflicts with `model_json`, `tuples` and `tests`.",
+				Optional:            true,
+			},
+			"model_json": schema.StringAttribute{
+				MarkdownDescription: "The authorization model definition in JSON format. Conflicts with `store_file_path`.",
+				CustomType:          jsontypes.NormalizedType{},
+				Optional:            true,
+			},
+			"tuples": schema.ListNestedAttribute{
+				MarkdownDescription: "The relationship tuples written for every test. Conflicts with `store_file_path`.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: relationshipTupleSchema(),
+				},
+			},
+			"tests": schema.ListNestedAttribute{
+				MarkdownDescription: "The tests to run. Conflicts with `store_file_path`.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the test.",
+							Required:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "A description of the test.",
+							Optional:            true,
+						},
+						"tuples": schema.ListNestedAttribute{
+							MarkdownDescription: "The relationship tuples written in addition to the global tuples for this test.",
+							Optional:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: relationshipTupleSchema(),
+							},
+						},
+						"check": schema.ListNestedAttribute{
+							MarkdownDescription: "Assertions of check queries.",
+							Optional:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"user": schema.StringAttribute{
+										MarkdownDescription: "The user of the check queries.",
+										Required:            true,
+									},
+									"object": schema.StringAttribute{
+										MarkdownDescription: "The object of the check queries.",
+										Required:            true,
+									},
+									"context_json": schema.StringAttribute{
+										MarkdownDescription: "The (partial) context under which the condition is evaluated.",
+										CustomType:          jsontypes.NormalizedType{},
+										Optional:            true,
+									},
+									"assertions": schema.MapAttribute{
+										MarkdownDescription: "The expected result of the check query per relation.",
+										ElementType:         types.BoolType,
+										Required:            true,
+									},
+								},
+							},
+						},
+						"list_objects": schema.ListNestedAttribute{
+							MarkdownDescription: "Assertions of list objects queries.",
+							Optional:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"user": schema.StringAttribute{
+										MarkdownDescription: "The user of the list objects queries.",
+										Required:            true,
+									},
+									"type": schema.StringAttribute{
+										MarkdownDescription: "The object type of the list objects queries.",
+										Required:            true,
+									},
+									"context_json": schema.StringAttribute{
+										MarkdownDescription: "The (partial) context under which the condition is evaluated.",
+										CustomType:          jsontypes.NormalizedType{},
+										Optional:            true,
+									},
+									"assertions": schema.MapAttribute{
+										MarkdownDescription: "The expected objects of the list objects query per relation.",
+										ElementType:         types.ListType{ElemType: types.StringType},
+										Required:            true,
+									},
+								},
+							},
+						},
+						"list_users": schema.ListNestedAttribute{
+							MarkdownDescription: "Assertions of list users queries.",
+							Optional:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"object": schema.StringAttribute{
+										MarkdownDescription: "The object of the list users queries.",
+										Required:            true,
+									},
+									"user_filters": schema.ListNestedAttribute{
+										MarkdownDescription: "The user filters of the list users queries.",
+										Required:            true,
+										NestedObject: schema.NestedAttributeObject{
+											Attributes: map[string]schema.Attribute{
+												"type": schema.StringAttribute{
+													MarkdownDescription: "The user type to filter for.",
+													Required:            true,
+												},
+												"relation": schema.StringAttribute{
+													MarkdownDescription: "The relation of the userset to filter for.",
+													Optional:            true,
+												},
+											},
+										},
+									},
+									"context_json": schema.StringAttribute{
+										MarkdownDescription: "The (partial) context under which the condition is evaluated.",
+										CustomType:          jsontypes.NormalizedType{},
+										Optional:            true,
+									},
+									"assertions": schema.MapAttribute{
+										MarkdownDescription: "The expected users of the list users query per relation.",
+										ElementType:         types.ListType{ElemType: types.StringType},
+										Required:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"passed": schema.BoolAttribute{
+				MarkdownDescription: "Boolean value indicating whether all tests passed.",
+				Computed:            true,
+			},
+			"results": schema.ListNestedAttribute{
+				MarkdownDescription: "The results of the tests.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the test.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the test.",
+							Computed:            true,
+						},
+						"passed": schema.BoolAttribute{
+							MarkdownDescription: "Boolean value indicating whether the test passed.",
+							Computed:            true,
+						},
+						"failures": schema.ListAttribute{
+							MarkdownDescription: "The failed assertions of the test.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d ModelTestDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("store_file_path"),
+			path.MatchRoot("model_json"),
+		),
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("store_file_path"),
+			path.MatchRoot("tuples"),
+		),
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("store_file_path"),
+			path.MatchRoot("tests"),
+		),
+	}
+}
+
+func (d *ModelTestDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providerdata.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = NewModelTestClient(providerData.Client)
+}
+
+func (d *ModelTestDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ModelTestDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modelJson := state.ModelJson.ValueString()
+
+	tuples := []relationshiptuple.RelationshipTupleWithConditionModel{}
+	if state.Tuples != nil {
+		tuples = *state.Tuples
+	}
+
+	tests := []ModelTestModel{}
+	if state.Tests != nil {
+		tests = *state.Tests
+	}
+
+	if !state.StoreFilePath.IsNull() {
+		storeFile, err := ParseStoreFile(state.StoreFilePath.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Store File Error", err.Error())
+			return
+		}
+
+		modelJson, err = storeFile.GetModelJson()
+		if err != nil {
+			resp.Diagnostics.AddError("Store File Error", fmt.Sprintf("Unable to read model of store file, got error: %s", err))
+			return
+		}
+
+		tupleFileTuples, err := storeFile.GetTuples()
+		if err != nil {
+			resp.Diagnostics.AddError("Store File Error", fmt.Sprintf("Unable to read tuples of store file, got error: %s", err))
+			return
+		}
+
+		tuples = relationshiptuple.NewRelationshipTupleWithConditionModelsFromTupleFileTuples(tupleFileTuples)
+
+		for _, storeFileTest := range storeFile.Tests {
+			test, err := NewModelTestModelFromStoreFileTest(storeFileTest)
+			if err != nil {
+				resp.Diagnostics.AddError("Store File Error", fmt.Sprintf("Unable to read test %q of store file, got error: %s", storeFileTest.Name, err))
+				return
+			}
+
+			tests = append(tests, *test)
+		}
+	}
+
+	state.Passed = types.BoolValue(true)
+	state.Results = []ModelTestResultModel{}
+
+	for _, test := range tests {
+		result, err := d.client.RunTest(ctx, modelJson, tuples, test)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to run model test %q, got error: %s", test.Name.ValueString(), err))
+			return
+		}
+
+		if !result.Passed.ValueBool() {
+			state.Passed = types.BoolValue(false)
+
+			for _, failure := range result.Failures {
+				resp.Diagnostics.AddError("Model Test Failed", fmt.Sprintf("Test %q failed: %s", test.Name.ValueString(), failure.ValueString()))
+			}
+		}
+
+		state.Results = append(state.Results, *result)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
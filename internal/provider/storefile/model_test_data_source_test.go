@@ -0,0 +1,127 @@
+package storefile_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/openfga/terraform-provider-openfga/internal/provider/acceptance"
+)
+
+func TestAccModelTestDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test store file
+			{
+				Config: testAccModelTestDataSourceConfigStoreFile(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.openfga_model_test.test",
+						tfjsonpath.New("passed"),
+						knownvalue.Bool(true),
+					),
+					statecheck.ExpectKnownValue(
+						"data.openfga_model_test.test",
+						tfjsonpath.New("results"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"name":        knownvalue.StringExact("owners-can-view"),
+								"description": knownvalue.StringExact("Owners are viewers of their documents"),
+								"passed":      knownvalue.Bool(true),
+								"failures":    knownvalue.ListSizeExact(0),
+							}),
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"name":        knownvalue.StringExact("conditional-viewers"),
+								"description": knownvalue.Null(),
+								"passed":      knownvalue.Bool(true),
+								"failures":    knownvalue.ListSizeExact(0),
+							}),
+						}),
+					),
+				},
+			},
+			// Test inline tests
+			{
+				Config: testAccModelTestDataSourceConfigInline(true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.openfga_model_test.test",
+						tfjsonpath.New("passed"),
+						knownvalue.Bool(true),
+					),
+				},
+			},
+			// Test failing inline tests
+			{
+				Config:      testAccModelTestDataSourceConfigInline(false),
+				ExpectError: regexp.MustCompile(`Test "inline" failed: check\(user=user:anne, relation=viewer,\s+object=document:1\): expected false, got true`),
+			},
+		},
+	})
+}
+
+func testAccModelTestDataSourceConfigStoreFile() string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "openfga_model_test" "test" {
+	store_file_path = "${path.root}/../acceptance/storefile/store.fga.yaml"
+}
+`, acceptance.ProviderConfig)
+}
+
+func testAccModelTestDataSourceConfigInline(expectation bool) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "openfga_authorization_model_document" "test" {
+	dsl = <<EOT
+model
+	schema 1.1
+
+type user
+
+type document
+	relations
+		define viewer: [user]
+	EOT
+}
+
+data "openfga_model_test" "test" {
+	model_json = data.openfga_authorization_model_document.test.result
+
+	tuples = [{
+		user     = "user:anne"
+		relation = "viewer"
+		object   = "document:1"
+	}]
+
+	tests = [{
+		name = "inline"
+
+		check = [{
+			user   = "user:anne"
+			object = "document:1"
+			assertions = {
+				viewer = %[2]t
+			}
+		}]
+
+		list_objects = [{
+			user = "user:anne"
+			type = "document"
+			assertions = {
+				viewer = ["document:1"]
+			}
+		}]
+	}]
+}
+`, acceptance.ProviderConfig, expectation)
+}
@@ -0,0 +1,173 @@
+package storefile
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/openfga/terraform-provider-openfga/internal/provider/query"
+	"github.com/openfga/terraform-provider-openfga/internal/provider/relationshiptuple"
+)
+
+type ModelTestCheckModel struct {
+	User   types.String `tfsdk:"user"`
+	Object types.String `tfsdk:"object"`
+	relationshiptuple.ContextModel
+	Assertions map[string]types.Bool `tfsdk:"assertions"`
+}
+
+type ModelTestListObjectsModel struct {
+	User types.String `tfsdk:"user"`
+	Type types.String `tfsdk:"type"`
+	relationshiptuple.ContextModel
+	Assertions map[string][]types.String `tfsdk:"assertions"`
+}
+
+type ModelTestListUsersModel struct {
+	Object      types.String            `tfsdk:"object"`
+	UserFilters []query.UserFilterModel `tfsdk:"user_filters"`
+	relationshiptuple.ContextModel
+	Assertions map[string][]types.String `tfsdk:"assertions"`
+}
+
+type ModelTestModel struct {
+	Name        types.String                                             `tfsdk:"name"`
+	Description types.String                                             `tfsdk:"description"`
+	Tuples      *[]relationshiptuple.RelationshipTupleWithConditionModel `tfsdk:"tuples"`
+	Check       *[]ModelTestCheckModel                                   `tfsdk:"check"`
+	ListObjects *[]ModelTestListObjectsModel                             `tfsdk:"list_objects"`
+	ListUsers   *[]ModelTestListUsersModel                               `tfsdk:"list_users"`
+}
+
+func (model ModelTestModel) GetTuples() []relationshiptuple.RelationshipTupleWithConditionModel {
+	if model.Tuples == nil {
+		return []relationshiptuple.RelationshipTupleWithConditionModel{}
+	}
+
+	return *model.Tuples
+}
+
+func (model ModelTestModel) GetCheck() []ModelTestCheckModel {
+	if model.Check == nil {
+		return []ModelTestCheckModel{}
+	}
+
+	return *model.Check
+}
+
+func (model ModelTestModel) GetListObjects() []ModelTestListObjectsModel {
+	if model.ListObjects == nil {
+		return []ModelTestListObjectsModel{}
+	}
+
+	return *model.ListObjects
+}
+
+func (model ModelTestModel) GetListUsers() []ModelTestListUsersModel {
+	if model.ListUsers == nil {
+		return []ModelTestListUsersModel{}
+	}
+
+	return *model.ListUsers
+}
+
+type ModelTestResultModel struct {
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	Passed      types.Bool     `tfsdk:"passed"`
+	Failures    []types.String `tfsdk:"failures"`
+}
+
+func NewModelTestModelFromStoreFileTest(test StoreFileTest) (*ModelTestModel, error) {
+	tuples, err := test.GetTuples()
+	if err != nil {
+		return nil, err
+	}
+
+	relationshipTupleModels := relationshiptuple.NewRelationshipTupleWithConditionModelsFromTupleFileTuples(tuples)
+
+	checkModels := []ModelTestCheckModel{}
+	for _, check := range test.Check {
+		users := append([]string{}, check.Users...)
+		if check.User != "" {
+			users = append(users, check.User)
+		}
+
+		objects := append([]string{}, check.Objects...)
+		if check.Object != "" {
+			objects = append(objects, check.Object)
+		}
+
+		assertions := map[string]types.Bool{}
+		for relation, expectation := range check.Assertions {
+			assertions[relation] = types.BoolValue(expectation)
+		}
+
+		for _, user := range users {
+			for _, object := range objects {
+				checkModels = append(checkModels, ModelTestCheckModel{
+					User:         types.StringValue(user),
+					Object:       types.StringValue(object),
+					ContextModel: *relationshiptuple.NewContextModel(check.Context),
+					Assertions:   assertions,
+				})
+			}
+		}
+	}
+
+	listObjectsModels := []ModelTestListObjectsModel{}
+	for _, listObjects := range test.ListObjects {
+		assertions := map[string][]types.String{}
+		for relation, objects := range listObjects.Assertions {
+			assertions[relation] = toStringValues(objects)
+		}
+
+		listObjectsModels = append(listObjectsModels, ModelTestListObjectsModel{
+			User:         types.StringValue(listObjects.User),
+			Type:         types.StringValue(listObjects.Type),
+			ContextModel: *relationshiptuple.NewContextModel(listObjects.Context),
+			Assertions:   assertions,
+		})
+	}
+
+	listUsersModels := []ModelTestListUsersModel{}
+	for _, listUsers := range test.ListUsers {
+		userFilters := []query.UserFilterModel{}
+		for _, userFilter := range listUsers.UserFilter {
+			userFilters = append(userFilters, *query.NewUserFilterModel(userFilter.Type, userFilter.Relation))
+		}
+
+		assertions := map[string][]types.String{}
+		for relation, assertion := range listUsers.Assertions {
+			assertions[relation] = toStringValues(assertion.Users)
+		}
+
+		listUsersModels = append(listUsersModels, ModelTestListUsersModel{
+			Object:       types.StringValue(listUsers.Object),
+			UserFilters:  userFilters,
+			ContextModel: *relationshiptuple.NewContextModel(listUsers.Context),
+			Assertions:   assertions,
+		})
+	}
+
+	description := types.StringNull()
+	if test.Description != "" {
+		description = types.StringValue(test.Description)
+	}
+
+	return &ModelTestModel{
+		Name:        types.StringValue(test.Name),
+		Description: description,
+		Tuples:      &relationshipTupleModels,
+		Check:       &checkModels,
+		ListObjects: &listObjectsModels,
+		ListUsers:   &listUsersModels,
+	}, nil
+}
+
+func toStringValues(values []string) []types.String {
+	stringValues := []types.String{}
+	for _, value := range values {
+		stringValues = append(stringValues, types.StringValue(value))
+	}
+
+	return stringValues
+}
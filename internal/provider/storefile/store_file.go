@@ -0,0 +1,156 @@
+package storefile
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/openfga/terraform-provider-openfga/internal/provider/authorizationmodel"
+	"github.com/openfga/terraform-provider-openfga/internal/provider/relationshiptuple"
+)
+
+// StoreFile describes an `.fga.yaml` store file as used by the OpenFGA CLI.
+type StoreFile struct {
+	Name       string                             `yaml:"name"`
+	Model      string                             `yaml:"model"`
+	ModelFile  string                             `yaml:"model_file"`
+	Tuples     []relationshiptuple.TupleFileTuple `yaml:"tuples"`
+	TupleFile  string                             `yaml:"tuple_file"`
+	TupleFiles []string                           `yaml:"tuple_files"`
+	Tests      []StoreFileTest                    `yaml:"tests"`
+}
+
+type StoreFileTest struct {
+	Name        string                             `yaml:"name"`
+	Description string                             `yaml:"description"`
+	Tuples      []relationshiptuple.TupleFileTuple `yaml:"tuples"`
+	TupleFile   string                             `yaml:"tuple_file"`
+	TupleFiles  []string                           `yaml:"tuple_files"`
+	Check       []StoreFileCheckTest               `yaml:"check"`
+	ListObjects []StoreFileListObjectsTest         `yaml:"list_objects"`
+	ListUsers   []StoreFileListUsersTest           `yaml:"list_users"`
+}
+
+type StoreFileCheckTest struct {
+	User       string                  `yaml:"user"`
+	Users      []string                `yaml:"users"`
+	Object     string                  `yaml:"object"`
+	Objects    []string                `yaml:"objects"`
+	Context    *map[string]interface{} `yaml:"context"`
+	Assertions map[string]bool         `yaml:"assertions"`
+}
+
+type StoreFileListObjectsTest struct {
+	User       string                  `yaml:"user"`
+	Type       string                  `yaml:"type"`
+	Context    *map[string]interface{} `yaml:"context"`
+	Assertions map[string][]string     `yaml:"assertions"`
+}
+
+type StoreFileUserFilter struct {
+	Type     string `yaml:"type"`
+	Relation string `yaml:"relation"`
+}
+
+type StoreFileListUsersAssertion struct {
+	Users []string `yaml:"users"`
+}
+
+type StoreFileListUsersTest struct {
+	Object     string                                 `yaml:"object"`
+	UserFilter []StoreFileUserFilter                  `yaml:"user_filter"`
+	Context    *map[string]interface{}                `yaml:"context"`
+	Assertions map[string]StoreFileListUsersAssertion `yaml:"assertions"`
+}
+
+// ParseStoreFile reads an `.fga.yaml` store file. Referenced model and tuple
+// files are resolved relative to the directory of the store file.
+func ParseStoreFile(storeFilePath string) (*StoreFile, error) {
+	storeFileBytes, err := os.ReadFile(storeFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read store file, got error: %s", err)
+	}
+
+	var storeFile StoreFile
+	err = yaml.Unmarshal(storeFileBytes, &storeFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse store file, got error: %s", err)
+	}
+
+	storeFileDirectory := filepath.Dir(storeFilePath)
+
+	if storeFile.ModelFile != "" {
+		storeFile.ModelFile = resolvePath(storeFileDirectory, storeFile.ModelFile)
+	}
+
+	storeFile.TupleFiles = resolveTupleFilePaths(storeFileDirectory, storeFile.TupleFile, storeFile.TupleFiles)
+	storeFile.TupleFile = ""
+
+	for index := range storeFile.Tests {
+		test := &storeFile.Tests[index]
+		test.TupleFiles = resolveTupleFilePaths(storeFileDirectory, test.TupleFile, test.TupleFiles)
+		test.TupleFile = ""
+	}
+
+	return &storeFile, nil
+}
+
+func resolvePath(directory string, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+
+	return filepath.Join(directory, path)
+}
+
+func resolveTupleFilePaths(directory string, tupleFile string, tupleFiles []string) []string {
+	resolvedTupleFiles := []string{}
+	if tupleFile != "" {
+		resolvedTupleFiles = append(resolvedTupleFiles, resolvePath(directory, tupleFile))
+	}
+
+	for _, path := range tupleFiles {
+		resolvedTupleFiles = append(resolvedTupleFiles, resolvePath(directory, path))
+	}
+
+	return resolvedTupleFiles
+}
+
+// GetModelJson returns the model of the store file in a stable JSON format.
+func (storeFile StoreFile) GetModelJson() (string, error) {
+	if storeFile.ModelFile != "" {
+		return authorizationmodel.TransformModelFileToJson(storeFile.ModelFile)
+	}
+
+	if storeFile.Model != "" {
+		return authorizationmodel.TransformDslToJson(storeFile.Model)
+	}
+
+	return "", fmt.Errorf("store file has to define either model or model_file")
+}
+
+// GetTuples returns the inline tuples of the store file together with the
+// tuples of all referenced tuple files.
+func (storeFile StoreFile) GetTuples() ([]relationshiptuple.TupleFileTuple, error) {
+	return collectTuples(storeFile.Tuples, storeFile.TupleFiles)
+}
+
+func (test StoreFileTest) GetTuples() ([]relationshiptuple.TupleFileTuple, error) {
+	return collectTuples(test.Tuples, test.TupleFiles)
+}
+
+func collectTuples(inlineTuples []relationshiptuple.TupleFileTuple, tupleFiles []string) ([]relationshiptuple.TupleFileTuple, error) {
+	tuples := append([]relationshiptuple.TupleFileTuple{}, inlineTuples...)
+	for _, tupleFile := range tupleFiles {
+		fileTuples, err := relationshiptuple.ParseTupleFile(tupleFile)
+		if err != nil {
+			return nil, err
+		}
+
+		tuples = append(tuples, fileTuples...)
+	}
+
+	return tuples, nil
+}
@@ -0,0 +1,277 @@
+package storefile
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/openfga/terraform-provider-openfga/internal/provider/relationshiptuple"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &StoreFileDataSource{}
+var _ datasource.DataSourceWithConfigure = &StoreFileDataSource{}
+
+func NewStoreFileDataSource() datasource.DataSource {
+	return &StoreFileDataSource{}
+}
+
+type StoreFileDataSource struct{}
+
+type StoreFileDataSourceModel struct {
+	StoreFilePath types.String `tfsdk:"store_file_path"`
+
+	Name      types.String                                            `tfsdk:"name"`
+	ModelJson jsontypes.Normalized                                    `tfsdk:"model_json"`
+	Tuples    []relationshiptuple.RelationshipTupleWithConditionModel `tfsdk:"tuples"`
+	Tests     []ModelTestModel                                        `tfsdk:"tests"`
+}
+
+func (d *StoreFileDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_store_file"
+}
+
+func computedRelationshipTupleSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"user": schema.StringAttribute{
+			MarkdownDescription: "The user of the relationship tuple.",
+			Computed:            true,
+		},
+		"relation": schema.StringAttribute{
+			MarkdownDescription: "The relation of the relationship tuple.",
+			Computed:            true,
+		},
+		"object": schema.StringAttribute{
+			MarkdownDescription: "The object of the relationship tuple.",
+			Computed:            true,
+		},
+		"condition": schema.SingleNestedAttribute{
+			MarkdownDescription: "A condition of the relationship tuple.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					MarkdownDescription: "The name of the condition.",
+					Computed:            true,
+				},
+				"context_json": schema.StringAttribute{
+					MarkdownDescription: "The (partial) context under which the condition is evaluated.",
+					CustomType:          jsontypes.NormalizedType{},
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+func (d *StoreFileDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Parses an ` + "`.fga.yaml`" + ` store file of the OpenFGA CLI without applying it.
+
+Referenced model and tuple files are resolved relative to the store file. The model is converted into the same JSON format as [` + "`openfga_authorization_model_document`" + `](authorization_model_document), and the tests have the same structure as the ` + "`tests`" + ` of [` + "`openfga_model_test`" + `](model_test). This allows to compose the parts of the store file with the other resources and data sources, e.g. ` + "`openfga_authorization_model`" + ` or ` + "`openfga_relationship_tuple`" + `.
+`,
+
+		Attributes: map[string]schema.Attribute{
+			"store_file_path": schema.StringAttribute{
+				MarkdownDescription: "The path of the `.fga.yaml` store file.",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the store file.",
+				Computed:            true,
+			},
+			"model_json": schema.StringAttribute{
+				MarkdownDescription: "The authorization model of the store file in JSON format.",
+				CustomType:          jsontypes.NormalizedType{},
+				Computed:            true,
+			},
+			"tuples": schema.ListNestedAttribute{
+				MarkdownDescription: "The relationship tuples of the store file, including the ones of all referenced tuple files. Duplicates are removed.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: computedRelationshipTupleSchema(),
+				},
+			},
+			"tests": schema.ListNestedAttribute{
+				MarkdownDescription: "The tests of the store file. Checks with multiple users or objects are expanded into a check per user and object.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the test.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "A description of the test.",
+							Computed:            true,
+						},
+						"tuples": schema.ListNestedAttribute{
+							MarkdownDescription: "The relationship tuples written in addition to the global tuples for this test.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: computedRelationshipTupleSchema(),
+							},
+						},
+						"check": schema.ListNestedAttribute{
+							MarkdownDescription: "Assertions of check queries.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"user": schema.StringAttribute{
+										MarkdownDescription: "The user of the check queries.",
+										Computed:            true,
+									},
+									"object": schema.StringAttribute{
+										MarkdownDescription: "The object of the check queries.",
+										Computed:            true,
+									},
+									"context_json": schema.StringAttribute{
+										MarkdownDescription: "The (partial) context under which the condition is evaluated.",
+										CustomType:          jsontypes.NormalizedType{},
+										Computed:            true,
+									},
+									"assertions": schema.MapAttribute{
+										MarkdownDescription: "The expected result of the check query per relation.",
+										ElementType:         types.BoolType,
+										Computed:            true,
+									},
+								},
+							},
+						},
+						"list_objects": schema.ListNestedAttribute{
+							MarkdownDescription: "Assertions of list objects queries.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"user": schema.StringAttribute{
+										MarkdownDescription: "The user of the list objects queries.",
+										Computed:            true,
+									},
+									"type": schema.StringAttribute{
+										MarkdownDescription: "The object type of the list objects queries.",
+										Computed:            true,
+									},
+									"context_json": schema.StringAttribute{
+										MarkdownDescription: "The (partial) context under which the condition is evaluated.",
+										CustomType:          jsontypes.NormalizedType{},
+										Computed:            true,
+									},
+									"assertions": schema.MapAttribute{
+										MarkdownDescription: "The expected objects of the list objects query per relation.",
+										ElementType:         types.ListType{ElemType: types.StringType},
+										Computed:            true,
+									},
+								},
+							},
+						},
+						"list_users": schema.ListNestedAttribute{
+							MarkdownDescription: "Assertions of list users queries.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"object": schema.StringAttribute{
+										MarkdownDescription: "The object of the list users queries.",
+										Computed:            true,
+									},
+									"user_filters": schema.ListNestedAttribute{
+										MarkdownDescription: "The user filters of the list users queries.",
+										Computed:            true,
+										NestedObject: schema.NestedAttributeObject{
+											Attributes: map[string]schema.Attribute{
+												"type": schema.StringAttribute{
+													MarkdownDescription: "The user type to filter for.",
+													Computed:            true,
+												},
+												"relation": schema.StringAttribute{
+													MarkdownDescription: "The relation of the userset to filter for.",
+													Computed:            true,
+												},
+											},
+										},
+									},
+									"context_json": schema.StringAttribute{
+										MarkdownDescription: "The (partial) context under which the condition is evaluated.",
+										CustomType:          jsontypes.NormalizedType{},
+										Computed:            true,
+									},
+									"assertions": schema.MapAttribute{
+										MarkdownDescription: "The expected users of the list users query per relation.",
+										ElementType:         types.ListType{ElemType: types.StringType},
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *StoreFileDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+}
+
+func (d *StoreFileDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state StoreFileDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	storeFile, err := ParseStoreFile(state.StoreFilePath.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Store File Error", err.Error())
+		return
+	}
+
+	modelJson, err := storeFile.GetModelJson()
+	if err != nil {
+		resp.Diagnostics.AddError("Store File Error", fmt.Sprintf("Unable to read model of store file, got error: %s", err))
+		return
+	}
+
+	tuples, err := storeFile.GetTuples()
+	if err != nil {
+		resp.Diagnostics.AddError("Store File Error", fmt.Sprintf("Unable to read tuples of store file, got error: %s", err))
+		return
+	}
+
+	tuples, err = relationshiptuple.ValidateTupleFileTuples(tuples)
+	if err != nil {
+		resp.Diagnostics.AddError("Store File Error", fmt.Sprintf("Invalid tuples in store file, got error: %s", err))
+		return
+	}
+
+	tests := []ModelTestModel{}
+	for _, storeFileTest := range storeFile.Tests {
+		test, err := NewModelTestModelFromStoreFileTest(storeFileTest)
+		if err != nil {
+			resp.Diagnostics.AddError("Store File Error", fmt.Sprintf("Unable to read test %q of store file, got error: %s", storeFileTest.Name, err))
+			return
+		}
+
+		tests = append(tests, *test)
+	}
+
+	state.Name = types.StringNull()
+	if storeFile.Name != "" {
+		state.Name = types.StringValue(storeFile.Name)
+	}
+
+	state.ModelJson = jsontypes.NewNormalizedValue(modelJson)
+	state.Tuples = relationshiptuple.NewRelationshipTupleWithConditionModelsFromTupleFileTuples(tuples)
+	state.Tests = tests
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,109 @@
+package storefile_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/openfga/terraform-provider-openfga/internal/provider/acceptance"
+)
+
+func TestAccStoreFileDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test missing store file
+			{
+				Config:      testAccStoreFileDataSourceConfig("missing.fga.yaml"),
+				ExpectError: regexp.MustCompile(`unable to read store file`),
+			},
+			// Read testing
+			{
+				Config: testAccStoreFileDataSourceConfig("store.fga.yaml"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.openfga_store_file.test",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact("Store file"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.openfga_store_file.test",
+						tfjsonpath.New("model_json"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"data.openfga_store_file.test",
+						tfjsonpath.New("tuples"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"user":      knownvalue.StringExact("user:anne"),
+								"relation":  knownvalue.StringExact("owner"),
+								"object":    knownvalue.StringExact("document:1"),
+								"condition": knownvalue.Null(),
+							}),
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"user":      knownvalue.StringExact("user:anne"),
+								"relation":  knownvalue.StringExact("owner"),
+								"object":    knownvalue.StringExact("document:2"),
+								"condition": knownvalue.Null(),
+							}),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"data.openfga_store_file.test",
+						tfjsonpath.New("tests"),
+						knownvalue.ListSizeExact(2),
+					),
+					statecheck.ExpectKnownValue(
+						"data.openfga_store_file.test",
+						tfjsonpath.New("tests").AtSliceIndex(0).AtMapKey("check"),
+						knownvalue.ListSizeExact(3),
+					),
+					statecheck.ExpectKnownValue(
+						"data.openfga_store_file.test",
+						tfjsonpath.New("tests").AtSliceIndex(1).AtMapKey("tuples"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"user":     knownvalue.StringExact("user:carl"),
+								"relation": knownvalue.StringExact("viewer"),
+								"object":   knownvalue.StringExact("document:1"),
+								"condition": knownvalue.ObjectExact(map[string]knownvalue.Check{
+									"name":         knownvalue.StringExact("larger_than"),
+									"context_json": knownvalue.StringExact(`{"provided":100}`),
+								}),
+							}),
+						}),
+					),
+					// The parsed tests can be run by the model test data source
+					statecheck.ExpectKnownValue(
+						"data.openfga_model_test.test",
+						tfjsonpath.New("passed"),
+						knownvalue.Bool(true),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccStoreFileDataSourceConfig(storeFile string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "openfga_store_file" "test" {
+	store_file_path = "${path.root}/../acceptance/storefile/%[2]s"
+}
+
+data "openfga_model_test" "test" {
+	model_json = data.openfga_store_file.test.model_json
+	tuples     = data.openfga_store_file.test.tuples
+	tests      = data.openfga_store_file.test.tests
+}
+`, acceptance.ProviderConfig, storeFile)
+}
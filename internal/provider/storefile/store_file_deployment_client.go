@@ -0,0 +1,174 @@
+package storefile
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	openfga "github.com/openfga/go-sdk"
+	"github.com/openfga/go-sdk/client"
+
+	"github.com/openfga/terraform-provider-openfga/internal/provider/authorizationmodel"
+	"github.com/openfga/terraform-provider-openfga/internal/provider/relationshiptuple"
+)
+
+type StoreFileDeploymentClient struct {
+	client                   *client.OpenFgaClient
+	authorizationModelClient *authorizationmodel.AuthorizationModelClient
+	relationshipTupleClient  *relationshiptuple.RelationshipTupleClient
+}
+
+func NewStoreFileDeploymentClient(client *client.OpenFgaClient) *StoreFileDeploymentClient {
+	// The tuples are compared right after the model and tuples are written,
+	// so they are always read with higher consistency.
+	consistency := openfga.CONSISTENCYPREFERENCE_HIGHER_CONSISTENCY
+
+	return &StoreFileDeploymentClient{
+		client:                   client,
+		authorizationModelClient: authorizationmodel.NewAuthorizationModelClient(client),
+		relationshipTupleClient:  relationshiptuple.NewRelationshipTupleClient(client, consistency),
+	}
+}
+
+// StoreDeploymentStatus describes the current model and tuples of a store in
+// the form in which they are stored in the state of a deployment.
+type StoreDeploymentStatus struct {
+	AuthorizationModel *authorizationmodel.AuthorizationModelModel
+	TuplesSha256       string
+	TupleCount         int
+}
+
+// StoreDeploymentResult describes the changes of a deployment.
+type StoreDeploymentResult struct {
+	AuthorizationModelId string
+	TuplesWritten        int
+	TuplesDeleted        int
+}
+
+// readLatestAuthorizationModel returns the latest authorization model of the
+// store, or nil if no model was written yet.
+func (wrapper *StoreFileDeploymentClient) readLatestAuthorizationModel(ctx context.Context, storeId string) (*authorizationmodel.AuthorizationModelModel, error) {
+	options := client.ClientReadLatestAuthorizationModelOptions{
+		StoreId: openfga.PtrString(storeId),
+	}
+
+	response, err := wrapper.client.ReadLatestAuthorizationModel(ctx).Options(options).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	if response.AuthorizationModel == nil {
+		return nil, nil
+	}
+
+	return authorizationmodel.NewAuthorizationModelModelFromAuthorizationModel(*response.AuthorizationModel), nil
+}
+
+func (wrapper *StoreFileDeploymentClient) ReadDeploymentStatus(ctx context.Context, storeId string) (*StoreDeploymentStatus, error) {
+	authorizationModelModel, err := wrapper.readLatestAuthorizationModel(ctx, storeId)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read latest authorization model, got error: %s", err)
+	}
+
+	tuples, err := wrapper.relationshipTupleClient.ListRelationshipTuples(ctx, storeId, nil, "")
+	if err != nil {
+		return nil, fmt.Errorf("unable to read relationship tuples, got error: %s", err)
+	}
+
+	tupleKeys := tupleKeysOf(*tuples)
+
+	return &StoreDeploymentStatus{
+		AuthorizationModel: authorizationModelModel,
+		TuplesSha256:       tuplesSha256(tupleKeys),
+		TupleCount:         len(tupleKeys),
+	}, nil
+}
+
+// Deploy converges the store to the content of a store file. The model is
+// only written if it differs from the latest model of the store. Afterwards,
+// the missing relationship tuples are written, and all tuples which are not
+// part of the store file are deleted. The deployment is not atomic: if it
+// fails, the store is left partially converged.
+func (wrapper *StoreFileDeploymentClient) Deploy(ctx context.Context, storeId string, content StoreFileContent) (*StoreDeploymentResult, error) {
+	authorizationModelModel, err := wrapper.readLatestAuthorizationModel(ctx, storeId)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read latest authorization model, got error: %s", err)
+	}
+
+	modelJsonEqual := false
+	if authorizationModelModel != nil {
+		equal, diags := authorizationModelModel.ModelJson.StringSemanticEquals(ctx, jsontypes.NewNormalizedValue(content.ModelJson))
+		if diags.HasError() {
+			return nil, fmt.Errorf("unable to compare authorization models")
+		}
+		modelJsonEqual = equal
+	}
+
+	if !modelJsonEqual {
+		authorizationModelModel, err = wrapper.authorizationModelClient.CreateAuthorizationModel(ctx, storeId, *authorizationmodel.NewAuthorizationModelModelWithModelJson("", content.ModelJson))
+		if err != nil {
+			return nil, fmt.Errorf("unable to write authorization model, got error: %s", err)
+		}
+	}
+
+	authorizationModelId := authorizationModelModel.GetId()
+
+	currentTuples, err := wrapper.relationshipTupleClient.ListRelationshipTuples(ctx, storeId, nil, "")
+	if err != nil {
+		return nil, fmt.Errorf("unable to read relationship tuples, got error: %s", err)
+	}
+
+	currentTupleKeys := tupleKeysOf(*currentTuples)
+	desiredTupleKeys := tupleKeysOf(content.Tuples)
+
+	deletes := []relationshiptuple.RelationshipTupleWithConditionModel{}
+	for key, tuple := range currentTupleKeys {
+		if _, ok := desiredTupleKeys[key]; !ok {
+			deletes = append(deletes, tuple)
+		}
+	}
+
+	currentRelationships := map[string]bool{}
+	for _, tuple := range *currentTuples {
+		currentRelationships[relationshipKey(tuple)] = true
+	}
+
+	// Tuples with a changed condition cannot be written while the tuple with
+	// the previous condition exists, so they are replaced after the deletes.
+	writes := []relationshiptuple.RelationshipTupleWithConditionModel{}
+	replacements := []relationshiptuple.RelationshipTupleWithConditionModel{}
+	for _, tuple := range content.Tuples {
+		if _, ok := currentTupleKeys[tupleKey(tuple)]; ok {
+			continue
+		}
+
+		if currentRelationships[relationshipKey(tuple)] {
+			replacements = append(replacements, tuple)
+		} else {
+			writes = append(writes, tuple)
+		}
+	}
+
+	// Missing tuples are written before obsolete ones are deleted, so that a
+	// failed deployment does not revoke access the store file still grants.
+	err = wrapper.relationshipTupleClient.CreateRelationshipTuples(ctx, storeId, &authorizationModelId, writes)
+	if err != nil {
+		return nil, fmt.Errorf("unable to write relationship tuples, got error: %s", err)
+	}
+
+	err = wrapper.relationshipTupleClient.DeleteRelationshipTuples(ctx, storeId, &authorizationModelId, deletes)
+	if err != nil {
+		return nil, fmt.Errorf("unable to delete relationship tuples, got error: %s", err)
+	}
+
+	err = wrapper.relationshipTupleClient.CreateRelationshipTuples(ctx, storeId, &authorizationModelId, replacements)
+	if err != nil {
+		return nil, fmt.Errorf("unable to write relationship tuples, got error: %s", err)
+	}
+
+	return &StoreDeploymentResult{
+		AuthorizationModelId: authorizationModelId,
+		TuplesWritten:        len(writes) + len(replacements),
+		TuplesDeleted:        len(deletes),
+	}, nil
+}
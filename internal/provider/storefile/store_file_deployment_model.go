@@ -0,0 +1,121 @@
+package storefile
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/openfga/terraform-provider-openfga/internal/provider/relationshiptuple"
+)
+
+type StoreFileDeploymentModel struct {
+	StoreId              types.String         `tfsdk:"store_id"`
+	StoreFilePath        types.String         `tfsdk:"store_file_path"`
+	ModelJson            jsontypes.Normalized `tfsdk:"model_json"`
+	AuthorizationModelId types.String         `tfsdk:"authorization_model_id"`
+	TuplesSha256         types.String         `tfsdk:"tuples_sha256"`
+	TupleCount           types.Int64          `tfsdk:"tuple_count"`
+	TuplesWritten        types.Int64          `tfsdk:"tuples_written"`
+	TuplesDeleted        types.Int64          `tfsdk:"tuples_deleted"`
+}
+
+func (model StoreFileDeploymentModel) GetStoreId() string {
+	return model.StoreId.ValueString()
+}
+
+func (model StoreFileDeploymentModel) GetStoreFilePath() string {
+	return model.StoreFilePath.ValueString()
+}
+
+// StoreFileContent is the model and the validated relationship tuples of a
+// store file, which a store is converged to.
+type StoreFileContent struct {
+	ModelJson string
+	Tuples    []relationshiptuple.RelationshipTupleWithConditionModel
+}
+
+// ReadStoreFileContent parses a store file together with its referenced model
+// and tuple files.
+func ReadStoreFileContent(storeFilePath string) (*StoreFileContent, error) {
+	storeFile, err := ParseStoreFile(storeFilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	modelJson, err := storeFile.GetModelJson()
+	if err != nil {
+		return nil, err
+	}
+
+	tuples, err := storeFile.GetTuples()
+	if err != nil {
+		return nil, err
+	}
+
+	tuples, err = relationshiptuple.ValidateTupleFileTuples(tuples)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StoreFileContent{
+		ModelJson: modelJson,
+		Tuples:    relationshiptuple.NewRelationshipTupleWithConditionModelsFromTupleFileTuples(tuples),
+	}, nil
+}
+
+// tupleKey identifies a relationship tuple including its condition, so that a
+// tuple with a changed condition is replaced.
+func tupleKey(tuple relationshiptuple.RelationshipTupleWithConditionModel) string {
+	condition := ""
+	if tuple.Condition != nil {
+		condition = tuple.Condition.GetName()
+
+		// The context is marshalled again to get a canonical key order
+		context, err := tuple.Condition.GetContextMap()
+		if err == nil && context != nil && len(*context) > 0 {
+			contextBytes, _ := json.Marshal(context)
+			condition += " " + string(contextBytes)
+		}
+	}
+
+	return strings.Join([]string{tuple.GetUser(), tuple.GetRelation(), tuple.GetObject(), condition}, " ")
+}
+
+// relationshipKey identifies a relationship tuple regardless of its condition.
+func relationshipKey(tuple relationshiptuple.RelationshipTupleWithConditionModel) string {
+	return strings.Join([]string{tuple.GetUser(), tuple.GetRelation(), tuple.GetObject()}, " ")
+}
+
+// tuplesSha256 returns a digest of a set of relationship tuples, which is
+// independent of their order.
+func tuplesSha256(tupleKeys map[string]relationshiptuple.RelationshipTupleWithConditionModel) string {
+	keys := []string{}
+	for key := range tupleKeys {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	digest := sha256.Sum256([]byte(strings.Join(keys, "\n")))
+
+	return hex.EncodeToString(digest[:])
+}
+
+func tupleKeysOf(tuples []relationshiptuple.RelationshipTupleWithConditionModel) map[string]relationshiptuple.RelationshipTupleWithConditionModel {
+	tupleKeys := map[string]relationshiptuple.RelationshipTupleWithConditionModel{}
+	for _, tuple := range tuples {
+		tupleKeys[tupleKey(tuple)] = tuple
+	}
+
+	return tupleKeys
+}
+
+// TuplesSha256 returns the digest of the relationship tuples of the store
+// file, as stored in `tuples_sha256`.
+func (content StoreFileContent) TuplesSha256() string {
+	return tuplesSha256(tupleKeysOf(content.Tuples))
+}
@@ -0,0 +1,298 @@
+package storefile
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	internalError "github.com/openfga/terraform-provider-openfga/internal/apierror"
+	"github.com/openfga/terraform-provider-openfga/internal/provider/providerdata"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &StoreFileDeploymentResource{}
+var _ resource.ResourceWithModifyPlan = &StoreFileDeploymentResource{}
+
+func NewStoreFileDeploymentResource() resource.Resource {
+	return &StoreFileDeploymentResource{}
+}
+
+type StoreFileDeploymentResource struct {
+	client *StoreFileDeploymentClient
+}
+
+func (r *StoreFileDeploymentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_store_file_deployment"
+}
+
+func (r *StoreFileDeploymentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Provides the ability to deploy an ` + "`.fga.yaml`" + ` store file of the OpenFGA CLI to a store, similar to ` + "`fga store import`" + `.
+
+The model (` + "`model`" + ` or ` + "`model_file`" + `) and the relationship tuples (` + "`tuples`" + `, ` + "`tuple_file`" + ` and ` + "`tuple_files`" + `) of the store file are read during planning. On apply, the model is only written if it differs from the latest model of the store. Afterwards, the relationship tuples of the store are converged to the ones of the store file: missing tuples are written, and all other tuples are deleted. The tests of the store file are ignored, consider using [` + "`openfga_model_test`" + `](../data-sources/model_test) to run them.
+
+Changes to the latest model or to the relationship tuples of the store outside of Terraform are detected on refresh and reverted on the next apply.
+
+~> All relationship tuples of the store which are not part of the store file are deleted. Do not combine this resource with ` + "`openfga_relationship_tuple`" + ` resources for the same store.
+
+~> The deployment is not atomic. Missing relationship tuples are written before the other tuples are deleted, so access granted by the store file is not revoked in between, but tuples whose condition changed are briefly missing while they are replaced. If an apply fails, the store is left partially converged, which is detected and completed by the next apply.
+
+~> Authorization models cannot be deleted in OpenFGA. Destroying this resource only removes it from the state, the model and the relationship tuples remain in the store.
+`,
+
+		Attributes: map[string]schema.Attribute{
+			"store_id": schema.StringAttribute{
+				MarkdownDescription: "The unique ID of the store the store file is deployed to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"store_file_path": schema.StringAttribute{
+				MarkdownDescription: "The path of the `.fga.yaml` store file. Model and tuple files are resolved relative to it.",
+				Required:            true,
+			},
+			"model_json": schema.StringAttribute{
+				MarkdownDescription: "The model of the store file in JSON format.",
+				CustomType:          jsontypes.NormalizedType{},
+				Computed:            true,
+			},
+			"authorization_model_id": schema.StringAttribute{
+				MarkdownDescription: "The unique ID of the deployed authorization model.",
+				Computed:            true,
+			},
+			"tuples_sha256": schema.StringAttribute{
+				MarkdownDescription: "The SHA-256 digest of the deployed relationship tuples, used to detect changes.",
+				Computed:            true,
+			},
+			"tuple_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of deployed relationship tuples.",
+				Computed:            true,
+			},
+			"tuples_written": schema.Int64Attribute{
+				MarkdownDescription: "The number of relationship tuples written by the last apply which changed relationship tuples.",
+				Computed:            true,
+			},
+			"tuples_deleted": schema.Int64Attribute{
+				MarkdownDescription: "The number of relationship tuples deleted by the last apply which changed relationship tuples.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *StoreFileDeploymentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providerdata.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = NewStoreFileDeploymentClient(providerData.Client)
+}
+
+func (r *StoreFileDeploymentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan StoreFileDeploymentModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The store file is only known during apply
+	if plan.StoreFilePath.IsUnknown() {
+		plan.ModelJson = jsontypes.NewNormalizedUnknown()
+		plan.AuthorizationModelId = types.StringUnknown()
+		plan.TuplesSha256 = types.StringUnknown()
+		plan.TupleCount = types.Int64Unknown()
+		plan.TuplesWritten = types.Int64Unknown()
+		plan.TuplesDeleted = types.Int64Unknown()
+
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	content, err := ReadStoreFileContent(plan.GetStoreFilePath())
+	if err != nil {
+		resp.Diagnostics.AddError("Input Error", fmt.Sprintf("Unable to read store file %q, got error: %s", plan.GetStoreFilePath(), err))
+		return
+	}
+
+	plan.ModelJson = jsontypes.NewNormalizedValue(content.ModelJson)
+	plan.AuthorizationModelId = types.StringUnknown()
+	plan.TuplesSha256 = types.StringValue(content.TuplesSha256())
+	plan.TupleCount = types.Int64Value(int64(len(content.Tuples)))
+	plan.TuplesWritten = types.Int64Unknown()
+	plan.TuplesDeleted = types.Int64Unknown()
+
+	if !req.State.Raw.IsNull() {
+		var state StoreFileDeploymentModel
+
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		modelJsonEqual, diags := plan.ModelJson.StringSemanticEquals(ctx, state.ModelJson)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// An unchanged model is not written again
+		if modelJsonEqual {
+			plan.ModelJson = state.ModelJson
+			plan.AuthorizationModelId = state.AuthorizationModelId
+		}
+
+		if plan.TuplesSha256.Equal(state.TuplesSha256) {
+			plan.TuplesWritten = state.TuplesWritten
+			plan.TuplesDeleted = state.TuplesDeleted
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// deploy converges the store to the store file and returns the new state.
+// The store file is read again, as it might have been unknown during planning.
+func (r *StoreFileDeploymentResource) deploy(ctx context.Context, plan StoreFileDeploymentModel) (*StoreFileDeploymentModel, error) {
+	content, err := ReadStoreFileContent(plan.GetStoreFilePath())
+	if err != nil {
+		return nil, fmt.Errorf("unable to read store file %q, got error: %s", plan.GetStoreFilePath(), err)
+	}
+
+	result, err := r.client.Deploy(ctx, plan.GetStoreId(), *content)
+	if err != nil {
+		return nil, err
+	}
+
+	state := plan
+	if plan.ModelJson.IsUnknown() {
+		state.ModelJson = jsontypes.NewNormalizedValue(content.ModelJson)
+	}
+	state.AuthorizationModelId = types.StringValue(result.AuthorizationModelId)
+	state.TuplesSha256 = types.StringValue(content.TuplesSha256())
+	state.TupleCount = types.Int64Value(int64(len(content.Tuples)))
+
+	// The counts of the last apply are kept if the tuples did not change
+	if plan.TuplesWritten.IsUnknown() || plan.TuplesDeleted.IsUnknown() {
+		state.TuplesWritten = types.Int64Value(int64(result.TuplesWritten))
+		state.TuplesDeleted = types.Int64Value(int64(result.TuplesDeleted))
+	}
+
+	return &state, nil
+}
+
+func (r *StoreFileDeploymentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan StoreFileDeploymentModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, err := r.deploy(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to deploy store file, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *StoreFileDeploymentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state StoreFileDeploymentModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	status, err := r.client.ReadDeploymentStatus(ctx, state.GetStoreId())
+	if err != nil {
+		if internalError.IsStatusNotFound(err) {
+			resp.Diagnostics.AddWarning(
+				"Store not found",
+				fmt.Sprintf("Store %q no longer exists; removing store file deployment from state.", state.GetStoreId()),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read store file deployment, got error: %s", err))
+		return
+	}
+
+	// A different latest model results in a plan to write the model again
+	if status.AuthorizationModel == nil {
+		state.ModelJson = jsontypes.NewNormalizedNull()
+		state.AuthorizationModelId = types.StringNull()
+	} else {
+		modelJsonEqual, diags := status.AuthorizationModel.ModelJson.StringSemanticEquals(ctx, state.ModelJson)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !modelJsonEqual {
+			state.ModelJson = status.AuthorizationModel.ModelJson
+		}
+		state.AuthorizationModelId = types.StringValue(status.AuthorizationModel.GetId())
+	}
+
+	state.TuplesSha256 = types.StringValue(status.TuplesSha256)
+	state.TupleCount = types.Int64Value(int64(status.TupleCount))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *StoreFileDeploymentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan StoreFileDeploymentModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, err := r.deploy(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to deploy store file, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *StoreFileDeploymentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Deletion is not possible, we treat it as a noop
+}
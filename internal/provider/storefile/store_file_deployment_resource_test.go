@@ -0,0 +1,178 @@
+package storefile_test
+
+import (
+	"fmt"
+	"os/exec"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/openfga/terraform-provider-openfga/internal/provider/acceptance"
+)
+
+func TestAccStoreFileDeploymentResource(t *testing.T) {
+	var storeID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid store file testing
+			{
+				Config:      testAccStoreFileDeploymentResourceConfig("missing.fga.yaml"),
+				ExpectError: regexp.MustCompile(`Unable to read store file`),
+			},
+			// Create and Read testing
+			{
+				Config: testAccStoreFileDeploymentResourceConfig("store.fga.yaml"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"openfga_store_file_deployment.test",
+						tfjsonpath.New("authorization_model_id"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"openfga_store_file_deployment.test",
+						tfjsonpath.New("tuple_count"),
+						knownvalue.Int64Exact(4),
+					),
+					statecheck.ExpectKnownValue(
+						"openfga_store_file_deployment.test",
+						tfjsonpath.New("tuples_written"),
+						knownvalue.Int64Exact(4),
+					),
+					statecheck.ExpectKnownValue(
+						"openfga_store_file_deployment.test",
+						tfjsonpath.New("tuples_deleted"),
+						knownvalue.Int64Exact(0),
+					),
+				},
+				Check: func(s *terraform.State) error {
+					// Capture the store ID for later use in drift testing
+					storeID = s.RootModule().Resources["openfga_store.test"].Primary.ID
+					return nil
+				},
+			},
+			// Update testing
+			{
+				Config: testAccStoreFileDeploymentResourceConfig("updated.fga.yaml"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							"openfga_store_file_deployment.test",
+							plancheck.ResourceActionUpdate,
+						),
+						plancheck.ExpectUnknownValue(
+							"openfga_store_file_deployment.test",
+							tfjsonpath.New("authorization_model_id"),
+						),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"openfga_store_file_deployment.test",
+						tfjsonpath.New("tuple_count"),
+						knownvalue.Int64Exact(4),
+					),
+					statecheck.ExpectKnownValue(
+						"openfga_store_file_deployment.test",
+						tfjsonpath.New("tuples_written"),
+						knownvalue.Int64Exact(2),
+					),
+					statecheck.ExpectKnownValue(
+						"openfga_store_file_deployment.test",
+						tfjsonpath.New("tuples_deleted"),
+						knownvalue.Int64Exact(2),
+					),
+				},
+			},
+			// Drift testing: write a tuple externally, then plan and apply its deletion
+			{
+				PreConfig: func() {
+					if storeID != "" {
+						jsonBody := `{"writes":{"tuple_keys":[{"user":"user:dave","relation":"owner","object":"document:3"}]}}`
+						cmd := exec.Command("curl", "-X", "POST", "-H", "Content-Type: application/json", "-d", jsonBody, "http://localhost:8080/stores/"+storeID+"/write")
+						err := cmd.Run()
+						if err != nil {
+							t.Fatal(err)
+						}
+					}
+				},
+				Config: testAccStoreFileDeploymentResourceConfig("updated.fga.yaml"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							"openfga_store_file_deployment.test",
+							plancheck.ResourceActionUpdate,
+						),
+						plancheck.ExpectKnownValue(
+							"openfga_store_file_deployment.test",
+							tfjsonpath.New("authorization_model_id"),
+							knownvalue.NotNull(),
+						),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"openfga_store_file_deployment.test",
+						tfjsonpath.New("tuple_count"),
+						knownvalue.Int64Exact(4),
+					),
+					statecheck.ExpectKnownValue(
+						"openfga_store_file_deployment.test",
+						tfjsonpath.New("tuples_written"),
+						knownvalue.Int64Exact(0),
+					),
+					statecheck.ExpectKnownValue(
+						"openfga_store_file_deployment.test",
+						tfjsonpath.New("tuples_deleted"),
+						knownvalue.Int64Exact(1),
+					),
+				},
+			},
+			// Modular model testing
+			{
+				Config: testAccStoreFileDeploymentResourceConfig("modular.fga.yaml"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"openfga_store_file_deployment.test",
+						tfjsonpath.New("tuple_count"),
+						knownvalue.Int64Exact(1),
+					),
+					statecheck.ExpectKnownValue(
+						"openfga_store_file_deployment.test",
+						tfjsonpath.New("tuples_written"),
+						knownvalue.Int64Exact(1),
+					),
+					statecheck.ExpectKnownValue(
+						"openfga_store_file_deployment.test",
+						tfjsonpath.New("tuples_deleted"),
+						knownvalue.Int64Exact(4),
+					),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccStoreFileDeploymentResourceConfig(storeFile string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "openfga_store" "test" {
+	name = "test"
+}
+
+resource "openfga_store_file_deployment" "test" {
+	store_id        = openfga_store.test.id
+	store_file_path = "${path.root}/../acceptance/storefile/deployment/%[2]s"
+}
+`, acceptance.ProviderConfig, storeFile)
+}
@@ -0,0 +1,161 @@
+package querycache
+
+import (
+	"container/list"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// Cache holds the results of queries for a limited time. Once it is full, the
+// least recently used results are evicted. Concurrent calls for the same key
+// are deduplicated, so that only one of them performs the query.
+//
+// A nil *Cache is valid and performs every query without caching.
+type Cache struct {
+	ttl        time.Duration
+	maxEntries int
+	now        func() time.Time
+
+	mutex   sync.Mutex
+	entries map[string]*list.Element
+	order   *list.List
+	calls   map[string]*call
+}
+
+type entry struct {
+	key     string
+	value   interface{}
+	expires time.Time
+}
+
+// call is a query in flight, which callers for the same key wait for.
+type call struct {
+	done  chan struct{}
+	value interface{}
+	err   error
+}
+
+// uncached is a value which is returned, but not cached.
+type uncached struct {
+	value interface{}
+}
+
+// Uncached wraps a value returned by the function of Do, which is returned to
+// the waiting callers but not cached, e.g. as it is incomplete.
+func Uncached(value interface{}) interface{} {
+	return uncached{value: value}
+}
+
+func New(ttl time.Duration, maxEntries int) *Cache {
+	return &Cache{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		now:        time.Now,
+		entries:    map[string]*list.Element{},
+		order:      list.New(),
+		calls:      map[string]*call{},
+	}
+}
+
+// Key builds a cache key from the JSON encoding of the given parts.
+func Key(parts ...interface{}) (string, error) {
+	key, err := json.Marshal(parts)
+	if err != nil {
+		return "", err
+	}
+
+	return string(key), nil
+}
+
+// Do returns the cached value of the key, or calls fn to compute it. Errors
+// and values wrapped with Uncached are returned to all waiting callers, but
+// are not cached.
+func (cache *Cache) Do(key string, fn func() (interface{}, error)) (interface{}, error) {
+	if cache == nil {
+		value, err := fn()
+		if wrapped, ok := value.(uncached); ok {
+			value = wrapped.value
+		}
+
+		return value, err
+	}
+
+	cache.mutex.Lock()
+
+	if element, ok := cache.entries[key]; ok {
+		entry := element.Value.(*entry)
+		if cache.now().Before(entry.expires) {
+			cache.order.MoveToFront(element)
+			cache.mutex.Unlock()
+
+			return entry.value, nil
+		}
+
+		cache.remove(element)
+	}
+
+	if pending, ok := cache.calls[key]; ok {
+		cache.mutex.Unlock()
+		<-pending.done
+
+		return pending.value, pending.err
+	}
+
+	pending := &call{done: make(chan struct{})}
+	cache.calls[key] = pending
+	cache.mutex.Unlock()
+
+	pending.value, pending.err = fn()
+
+	cacheable := true
+	if wrapped, ok := pending.value.(uncached); ok {
+		pending.value = wrapped.value
+		cacheable = false
+	}
+
+	cache.mutex.Lock()
+	delete(cache.calls, key)
+	if pending.err == nil && cacheable {
+		cache.add(key, pending.value)
+	}
+	cache.mutex.Unlock()
+
+	close(pending.done)
+
+	return pending.value, pending.err
+}
+
+// Len returns the number of cached values, including expired ones which were
+// not evicted yet.
+func (cache *Cache) Len() int {
+	if cache == nil {
+		return 0
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	return cache.order.Len()
+}
+
+func (cache *Cache) add(key string, value interface{}) {
+	if element, ok := cache.entries[key]; ok {
+		cache.remove(element)
+	}
+
+	cache.entries[key] = cache.order.PushFront(&entry{
+		key:     key,
+		value:   value,
+		expires: cache.now().Add(cache.ttl),
+	})
+
+	for cache.maxEntries > 0 && cache.order.Len() > cache.maxEntries {
+		cache.remove(cache.order.Back())
+	}
+}
+
+func (cache *Cache) remove(element *list.Element) {
+	cache.order.Remove(element)
+	delete(cache.entries, element.Value.(*entry).key)
+}
@@ -0,0 +1,158 @@
+package querycache
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCacheDo(t *testing.T) {
+	t.Run("caches values until they expire", func(t *testing.T) {
+		now := time.Now()
+		cache := New(time.Minute, 10)
+		cache.now = func() time.Time { return now }
+
+		calls := 0
+		fn := func() (interface{}, error) {
+			calls++
+			return calls, nil
+		}
+
+		if value, _ := cache.Do("key", fn); value != 1 {
+			t.Fatalf("expected 1, got %v", value)
+		}
+
+		if value, _ := cache.Do("key", fn); value != 1 {
+			t.Fatalf("expected cached 1, got %v", value)
+		}
+
+		now = now.Add(time.Minute)
+
+		if value, _ := cache.Do("key", fn); value != 2 {
+			t.Fatalf("expected 2 after expiry, got %v", value)
+		}
+	})
+
+	t.Run("does not cache errors", func(t *testing.T) {
+		cache := New(time.Minute, 10)
+
+		calls := 0
+		fn := func() (interface{}, error) {
+			calls++
+			return nil, errors.New("failed")
+		}
+
+		for range 2 {
+			if _, err := cache.Do("key", fn); err == nil {
+				t.Fatalf("expected error")
+			}
+		}
+
+		if calls != 2 {
+			t.Fatalf("expected 2 calls, got %d", calls)
+		}
+	})
+
+	t.Run("evicts least recently used values", func(t *testing.T) {
+		cache := New(time.Minute, 2)
+
+		value := func(value string) func() (interface{}, error) {
+			return func() (interface{}, error) { return value, nil }
+		}
+
+		_, _ = cache.Do("a", value("a"))
+		_, _ = cache.Do("b", value("b"))
+		_, _ = cache.Do("a", value("a2"))
+		_, _ = cache.Do("c", value("c"))
+
+		if cache.Len() != 2 {
+			t.Fatalf("expected 2 entries, got %d", cache.Len())
+		}
+
+		if result, _ := cache.Do("a", value("a3")); result != "a" {
+			t.Fatalf("expected a to be retained, got %v", result)
+		}
+
+		if result, _ := cache.Do("b", value("b2")); result != "b2" {
+			t.Fatalf("expected b to be evicted, got %v", result)
+		}
+	})
+
+	t.Run("deduplicates concurrent calls", func(t *testing.T) {
+		cache := New(time.Minute, 10)
+
+		var calls atomic.Int32
+		release := make(chan struct{})
+		fn := func() (interface{}, error) {
+			calls.Add(1)
+			<-release
+			return "value", nil
+		}
+
+		var wait sync.WaitGroup
+		for range 10 {
+			wait.Add(1)
+			go func() {
+				defer wait.Done()
+				if value, _ := cache.Do("key", fn); value != "value" {
+					t.Errorf("expected value, got %v", value)
+				}
+			}()
+		}
+
+		for calls.Load() == 0 {
+			time.Sleep(time.Millisecond)
+		}
+		time.Sleep(10 * time.Millisecond)
+		close(release)
+		wait.Wait()
+
+		if calls.Load() != 1 {
+			t.Fatalf("expected 1 call, got %d", calls.Load())
+		}
+	})
+
+	t.Run("does not cache uncached values", func(t *testing.T) {
+		cache := New(time.Minute, 10)
+
+		calls := 0
+		fn := func() (interface{}, error) {
+			calls++
+			return Uncached(calls), nil
+		}
+
+		for index := range 2 {
+			value, err := cache.Do("key", fn)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if value != index+1 {
+				t.Fatalf("expected the unwrapped value %d, got %v", index+1, value)
+			}
+		}
+
+		if calls != 2 {
+			t.Fatalf("expected 2 calls, got %d", calls)
+		}
+	})
+
+	t.Run("nil cache calls through", func(t *testing.T) {
+		var cache *Cache
+
+		calls := 0
+		fn := func() (interface{}, error) {
+			calls++
+			return calls, nil
+		}
+
+		_, _ = cache.Do("key", fn)
+		_, _ = cache.Do("key", fn)
+
+		if calls != 2 {
+			t.Fatalf("expected 2 calls, got %d", calls)
+		}
+	})
+}
@@ -0,0 +1,209 @@
+package ratelimit
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/time/rate"
+)
+
+// Class is the kind of an OpenFGA API request, which can be limited
+// separately.
+type Class string
+
+const (
+	ClassRead  Class = "read"
+	ClassWrite Class = "write"
+	ClassQuery Class = "query"
+)
+
+// queryPaths are the path suffixes of the query endpoints of the OpenFGA API.
+var queryPaths = []string{
+	"/check",
+	"/batch-check",
+	"/expand",
+	"/list-objects",
+	"/streamed-list-objects",
+	"/list-users",
+}
+
+// Limits are the limits of a limiter. A zero value means no limit.
+type Limits struct {
+	MaxConcurrentRequests int
+	RequestsPerSecond     int
+}
+
+// Limiter limits the number of concurrent requests and the rate at which
+// requests are started.
+//
+// A nil *Limiter is valid and does not limit requests.
+type Limiter struct {
+	name        string
+	concurrency chan struct{}
+	rate        *rate.Limiter
+}
+
+// New creates a limiter with the given limits, or returns nil if the limits do
+// not limit anything. The name identifies the limiter in logs.
+func New(name string, limits Limits) *Limiter {
+	if limits.MaxConcurrentRequests <= 0 && limits.RequestsPerSecond <= 0 {
+		return nil
+	}
+
+	limiter := &Limiter{name: name}
+	if limits.MaxConcurrentRequests > 0 {
+		limiter.concurrency = make(chan struct{}, limits.MaxConcurrentRequests)
+	}
+	if limits.RequestsPerSecond > 0 {
+		limiter.rate = rate.NewLimiter(rate.Limit(limits.RequestsPerSecond), 1)
+	}
+
+	return limiter
+}
+
+// Acquire waits until a request may be started, and returns a function to be
+// called once the request finished.
+func (limiter *Limiter) Acquire(ctx context.Context) (func(), error) {
+	if limiter == nil {
+		return func() {}, nil
+	}
+
+	if limiter.concurrency != nil {
+		select {
+		case limiter.concurrency <- struct{}{}:
+		default:
+			tflog.Debug(ctx, "Throttling request, maximum number of concurrent requests reached", map[string]interface{}{
+				"limiter":                 limiter.name,
+				"max_concurrent_requests": cap(limiter.concurrency),
+			})
+
+			select {
+			case limiter.concurrency <- struct{}{}:
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+	}
+
+	release := func() {
+		if limiter.concurrency != nil {
+			<-limiter.concurrency
+		}
+	}
+
+	if limiter.rate != nil {
+		reservation := limiter.rate.Reserve()
+		if delay := reservation.Delay(); delay > 0 {
+			tflog.Debug(ctx, "Throttling request, requests per second exceeded", map[string]interface{}{
+				"limiter":             limiter.name,
+				"requests_per_second": int(limiter.rate.Limit()),
+				"delay":               delay.String(),
+			})
+
+			timer := time.NewTimer(delay)
+			defer timer.Stop()
+
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				reservation.Cancel()
+				release()
+				return nil, ctx.Err()
+			}
+		}
+	}
+
+	return release, nil
+}
+
+// Transport limits the requests sent through it by a limiter shared by all
+// requests, and by a limiter per class of request.
+type Transport struct {
+	base    http.RoundTripper
+	all     *Limiter
+	classes map[Class]*Limiter
+}
+
+// NewTransport wraps the base transport, or http.DefaultTransport if nil.
+// Classes without a limiter are only limited by the shared limiter.
+func NewTransport(base http.RoundTripper, all *Limiter, classes map[Class]*Limiter) *Transport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return &Transport{
+		base:    base,
+		all:     all,
+		classes: classes,
+	}
+}
+
+func (transport *Transport) RoundTrip(request *http.Request) (*http.Response, error) {
+	ctx := request.Context()
+
+	// The limiter of the class is acquired first, so that requests waiting
+	// for it do not hold back requests of other classes.
+	releaseClass, err := transport.classes[ClassOf(request)].Acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	releaseAll, err := transport.all.Acquire(ctx)
+	if err != nil {
+		releaseClass()
+		return nil, err
+	}
+
+	release := func() {
+		releaseAll()
+		releaseClass()
+	}
+
+	response, err := transport.base.RoundTrip(request)
+	if err != nil {
+		release()
+		return nil, err
+	}
+
+	// Streamed responses are still in flight until their body is closed.
+	response.Body = &releasingBody{ReadCloser: response.Body, release: release}
+
+	return response, nil
+}
+
+// releasingBody releases the limits of a request once its body is closed.
+type releasingBody struct {
+	io.ReadCloser
+
+	once    sync.Once
+	release func()
+}
+
+func (body *releasingBody) Close() error {
+	err := body.ReadCloser.Close()
+	body.once.Do(body.release)
+
+	return err
+}
+
+// ClassOf returns the class of an OpenFGA API request.
+func ClassOf(request *http.Request) Class {
+	path := strings.TrimSuffix(request.URL.Path, "/")
+
+	for _, queryPath := range queryPaths {
+		if strings.HasSuffix(path, queryPath) {
+			return ClassQuery
+		}
+	}
+
+	if request.Method == http.MethodGet || strings.HasSuffix(path, "/read") {
+		return ClassRead
+	}
+
+	return ClassWrite
+}
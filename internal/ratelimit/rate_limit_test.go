@@ -0,0 +1,125 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClassOf(t *testing.T) {
+	for _, test := range []struct {
+		method string
+		path   string
+		class  Class
+	}{
+		{http.MethodGet, "/stores", ClassRead},
+		{http.MethodGet, "/stores/01ARZ3NDEKTSV4RRFFQ69G5FAV/authorization-models", ClassRead},
+		{http.MethodPost, "/stores/01ARZ3NDEKTSV4RRFFQ69G5FAV/read", ClassRead},
+		{http.MethodPost, "/stores", ClassWrite},
+		{http.MethodDelete, "/stores/01ARZ3NDEKTSV4RRFFQ69G5FAV", ClassWrite},
+		{http.MethodPost, "/stores/01ARZ3NDEKTSV4RRFFQ69G5FAV/write", ClassWrite},
+		{http.MethodPost, "/stores/01ARZ3NDEKTSV4RRFFQ69G5FAV/authorization-models", ClassWrite},
+		{http.MethodPost, "/stores/01ARZ3NDEKTSV4RRFFQ69G5FAV/check", ClassQuery},
+		{http.MethodPost, "/stores/01ARZ3NDEKTSV4RRFFQ69G5FAV/batch-check", ClassQuery},
+		{http.MethodPost, "/stores/01ARZ3NDEKTSV4RRFFQ69G5FAV/streamed-list-objects", ClassQuery},
+		{http.MethodPost, "/stores/01ARZ3NDEKTSV4RRFFQ69G5FAV/list-users", ClassQuery},
+	} {
+		request := httptest.NewRequest(test.method, test.path, nil)
+		if class := ClassOf(request); class != test.class {
+			t.Errorf("expected %s %s to be a %s request, got %s", test.method, test.path, test.class, class)
+		}
+	}
+}
+
+func TestLimiter(t *testing.T) {
+	t.Run("limits concurrent requests", func(t *testing.T) {
+		var current, peak atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			value := current.Add(1)
+			for {
+				previous := peak.Load()
+				if value <= previous || peak.CompareAndSwap(previous, value) {
+					break
+				}
+			}
+			time.Sleep(20 * time.Millisecond)
+			current.Add(-1)
+		}))
+		defer server.Close()
+
+		httpClient := &http.Client{
+			Transport: NewTransport(nil, New("all", Limits{MaxConcurrentRequests: 2}), nil),
+		}
+
+		var wait sync.WaitGroup
+		for range 6 {
+			wait.Add(1)
+			go func() {
+				defer wait.Done()
+				response, err := httpClient.Get(server.URL)
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+					return
+				}
+				_ = response.Body.Close()
+			}()
+		}
+		wait.Wait()
+
+		if peak.Load() != 2 {
+			t.Fatalf("expected at most 2 concurrent requests, got %d", peak.Load())
+		}
+	})
+
+	t.Run("limits requests per second", func(t *testing.T) {
+		limiter := New("all", Limits{RequestsPerSecond: 20})
+
+		started := time.Now()
+		for range 5 {
+			release, err := limiter.Acquire(context.Background())
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			release()
+		}
+
+		// The first request starts immediately, the others every 50ms.
+		if elapsed := time.Since(started); elapsed < 200*time.Millisecond {
+			t.Fatalf("expected requests to be delayed, took %s", elapsed)
+		}
+	})
+
+	t.Run("stops waiting once the context is done", func(t *testing.T) {
+		limiter := New("all", Limits{MaxConcurrentRequests: 1})
+
+		release, err := limiter.Acquire(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		defer release()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		if _, err := limiter.Acquire(ctx); err == nil {
+			t.Fatalf("expected an error")
+		}
+	})
+
+	t.Run("nil limiter does not limit", func(t *testing.T) {
+		if limiter := New("all", Limits{}); limiter != nil {
+			t.Fatalf("expected no limiter")
+		}
+
+		var limiter *Limiter
+		release, err := limiter.Acquire(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		release()
+	})
+}
@@ -0,0 +1,245 @@
+package writebatcher
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	openfga "github.com/openfga/go-sdk"
+	"github.com/openfga/go-sdk/client"
+
+	"github.com/openfga/terraform-provider-openfga/internal/apierror"
+)
+
+// maxTuplesPerWrite is the maximum number of tuples the server accepts in a
+// single Write request.
+const maxTuplesPerWrite = 100
+
+// Batcher coalesces the writes and deletes of individual relationship tuples
+// into Write requests. Operations are queued per store and authorization
+// model, and sent once the window since the first queued operation elapsed or
+// the batch is full.
+//
+// A batch is sent as a single transaction. If it fails, its operations are
+// retried individually, so that every caller receives the result of its own
+// tuple, and a single invalid tuple does not fail the others of its batch.
+// If the response of the failed batch might have been lost, a retried
+// operation which conflicts with the store succeeds only if a read confirms
+// that the store holds the outcome of the operation.
+type Batcher struct {
+	client  *client.OpenFgaClient
+	window  time.Duration
+	maxSize int
+
+	mutex   sync.Mutex
+	batches map[string]*batch
+}
+
+// batch is the queue of operations for a store and authorization model.
+type batch struct {
+	ctx                  context.Context
+	storeId              string
+	authorizationModelId *string
+	operations           []*operation
+	timer                *time.Timer
+}
+
+// operation is a write or delete of a tuple, whose caller waits for done.
+type operation struct {
+	write  *client.ClientTupleKey
+	delete *client.ClientTupleKeyWithoutCondition
+	done   chan error
+}
+
+// New creates a batcher which sends a batch after window, or as soon as it
+// holds maxSize operations. maxSize is capped to the maximum number of tuples
+// per Write request.
+func New(client *client.OpenFgaClient, window time.Duration, maxSize int) *Batcher {
+	if maxSize <= 0 || maxSize > maxTuplesPerWrite {
+		maxSize = maxTuplesPerWrite
+	}
+
+	return &Batcher{
+		client:  client,
+		window:  window,
+		maxSize: maxSize,
+		batches: map[string]*batch{},
+	}
+}
+
+// Write writes the tuple as part of the next batch of the store and
+// authorization model, and returns once the batch was sent.
+func (batcher *Batcher) Write(ctx context.Context, storeId string, authorizationModelId *string, tuple client.ClientTupleKey) error {
+	return batcher.enqueue(ctx, storeId, authorizationModelId, &operation{write: &tuple, done: make(chan error, 1)})
+}
+
+// Delete deletes the tuple as part of the next batch of the store and
+// authorization model, and returns once the batch was sent.
+func (batcher *Batcher) Delete(ctx context.Context, storeId string, authorizationModelId *string, tuple client.ClientTupleKeyWithoutCondition) error {
+	return batcher.enqueue(ctx, storeId, authorizationModelId, &operation{delete: &tuple, done: make(chan error, 1)})
+}
+
+func (batcher *Batcher) enqueue(ctx context.Context, storeId string, authorizationModelId *string, pending *operation) error {
+	key := storeId + "|"
+	if authorizationModelId != nil {
+		key += *authorizationModelId
+	}
+
+	batcher.mutex.Lock()
+
+	current, ok := batcher.batches[key]
+	if !ok {
+		// The batch outlives the request of any single caller, so it is sent
+		// with the values but without the cancellation of the first one.
+		current = &batch{
+			ctx:                  context.WithoutCancel(ctx),
+			storeId:              storeId,
+			authorizationModelId: authorizationModelId,
+		}
+		current.timer = time.AfterFunc(batcher.window, func() {
+			batcher.flush(key, current)
+		})
+		batcher.batches[key] = current
+	}
+
+	current.operations = append(current.operations, pending)
+
+	full := len(current.operations) >= batcher.maxSize
+	if full {
+		current.timer.Stop()
+		delete(batcher.batches, key)
+	}
+
+	batcher.mutex.Unlock()
+
+	if full {
+		go batcher.send(current)
+	}
+
+	select {
+	case err := <-pending.done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// flush sends the batch once its window elapsed, unless it was already sent
+// because it was full.
+func (batcher *Batcher) flush(key string, current *batch) {
+	batcher.mutex.Lock()
+	if batcher.batches[key] != current {
+		batcher.mutex.Unlock()
+		return
+	}
+	delete(batcher.batches, key)
+	batcher.mutex.Unlock()
+
+	batcher.send(current)
+}
+
+func (batcher *Batcher) send(current *batch) {
+	err := batcher.write(current.ctx, current.storeId, current.authorizationModelId, current.operations...)
+
+	// A batch rejected for any other reason than a conflict was not written.
+	// Otherwise, the batch might have been written although its response was
+	// lost, e.g. if the client retried the request.
+	mayBeWritten := !apierror.IsClientError(err) || apierror.IsWriteConflict(err)
+
+	for _, pending := range current.operations {
+		if err == nil || len(current.operations) == 1 {
+			pending.done <- err
+			continue
+		}
+
+		// The batch is written in a single transaction, so a failure of any
+		// tuple fails all of them. Each tuple is retried on its own to return
+		// the error of its own tuple, if any.
+		go func() {
+			err := batcher.write(current.ctx, current.storeId, current.authorizationModelId, pending)
+			if mayBeWritten && apierror.IsWriteConflict(err) && batcher.isApplied(current.ctx, current.storeId, pending) {
+				err = nil
+			}
+
+			pending.done <- err
+		}()
+	}
+}
+
+// isApplied reports whether the store holds the outcome of the operation,
+// i.e. the exact tuple of a write including its condition, or no tuple for a
+// delete.
+func (batcher *Batcher) isApplied(ctx context.Context, storeId string, pending *operation) bool {
+	options := client.ClientReadOptions{
+		StoreId:     openfga.PtrString(storeId),
+		Consistency: openfga.CONSISTENCYPREFERENCE_HIGHER_CONSISTENCY.Ptr(),
+	}
+
+	body := client.ClientReadRequest{}
+	if pending.write != nil {
+		body.User, body.Relation, body.Object = &pending.write.User, &pending.write.Relation, &pending.write.Object
+	} else {
+		body.User, body.Relation, body.Object = &pending.delete.User, &pending.delete.Relation, &pending.delete.Object
+	}
+
+	response, err := batcher.client.Read(ctx).Options(options).Body(body).Execute()
+	if err != nil {
+		return false
+	}
+
+	if pending.write == nil {
+		return len(response.GetTuples()) == 0
+	}
+
+	for _, tuple := range response.GetTuples() {
+		if isSameCondition(tuple.Key.Condition, pending.write.Condition) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isSameCondition compares both conditions by name and by the JSON encoding
+// of their context, where a missing context equals an empty one.
+func isSameCondition(a *openfga.RelationshipCondition, b *openfga.RelationshipCondition) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	return a.Name == b.Name && encodeContext(a.Context) == encodeContext(b.Context)
+}
+
+func encodeContext(values *map[string]interface{}) string {
+	if values == nil {
+		return "{}"
+	}
+
+	encoded, err := json.Marshal(*values)
+	if err != nil {
+		return ""
+	}
+
+	return string(encoded)
+}
+
+func (batcher *Batcher) write(ctx context.Context, storeId string, authorizationModelId *string, operations ...*operation) error {
+	options := client.ClientWriteOptions{
+		StoreId:              openfga.PtrString(storeId),
+		AuthorizationModelId: authorizationModelId,
+	}
+
+	body := client.ClientWriteRequest{}
+	for _, pending := range operations {
+		if pending.write != nil {
+			body.Writes = append(body.Writes, *pending.write)
+		} else {
+			body.Deletes = append(body.Deletes, *pending.delete)
+		}
+	}
+
+	_, err := batcher.client.Write(ctx).Options(options).Body(body).Execute()
+
+	return err
+}
@@ -0,0 +1,287 @@
+package writebatcher
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	openfga "github.com/openfga/go-sdk"
+	"github.com/openfga/go-sdk/client"
+)
+
+const testStoreId = "01ARZ3NDEKTSV4RRFFQ69G5FAV"
+
+// testExistingTuples are the tuples of the store of the test server, by user.
+var testExistingTuples = map[string]openfga.Tuple{
+	"user:existing": {
+		Key: openfga.TupleKey{User: "user:existing", Relation: "viewer", Object: "document:1"},
+	},
+	"user:conditional": {
+		Key: openfga.TupleKey{User: "user:conditional", Relation: "viewer", Object: "document:1", Condition: &openfga.RelationshipCondition{
+			Name:    "in_region",
+			Context: &map[string]interface{}{"region": "eu"},
+		}},
+	},
+}
+
+// testServer records the tuples of every Write request, rejects requests which
+// write the user "user:invalid", and rejects requests which write an existing
+// tuple or delete the user "user:missing" as conflicts. Read requests return
+// the existing tuple of the user, if any.
+type testServer struct {
+	mutex    sync.Mutex
+	requests [][]string
+}
+
+func (server *testServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if strings.HasSuffix(r.URL.Path, "/read") {
+		server.serveRead(w, r)
+		return
+	}
+
+	var body openfga.WriteRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	tuples := []string{}
+	invalid := false
+	conflict := false
+	if body.Writes != nil {
+		for _, tuple := range body.Writes.TupleKeys {
+			tuples = append(tuples, "write "+tuple.User)
+			invalid = invalid || tuple.User == "user:invalid"
+			_, exists := testExistingTuples[tuple.User]
+			conflict = conflict || exists
+		}
+	}
+	if body.Deletes != nil {
+		for _, tuple := range body.Deletes.TupleKeys {
+			tuples = append(tuples, "delete "+tuple.User)
+			conflict = conflict || tuple.User == "user:missing"
+		}
+	}
+
+	server.mutex.Lock()
+	server.requests = append(server.requests, tuples)
+	server.mutex.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	if invalid {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"code":"validation_error","message":"invalid user"}`))
+		return
+	}
+
+	if conflict {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"code":"write_failed_due_to_invalid_input","message":"tuple to be written already existed or the tuple to be deleted did not exist"}`))
+		return
+	}
+
+	_, _ = w.Write([]byte(`{}`))
+}
+
+func (server *testServer) serveRead(w http.ResponseWriter, r *http.Request) {
+	var body openfga.ReadRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	response := openfga.ReadResponse{Tuples: []openfga.Tuple{}}
+	if tuple, ok := testExistingTuples[body.TupleKey.GetUser()]; ok {
+		response.Tuples = append(response.Tuples, tuple)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+func (server *testServer) Requests() [][]string {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	return server.requests
+}
+
+func newTestBatcher(t *testing.T, window time.Duration, maxSize int) (*Batcher, *testServer) {
+	server := &testServer{}
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+
+	fgaClient, err := client.NewSdkClient(&client.ClientConfiguration{
+		ApiUrl: httpServer.URL,
+	})
+	if err != nil {
+		t.Fatalf("unable to create client: %s", err)
+	}
+
+	return New(fgaClient, window, maxSize), server
+}
+
+func writeConcurrently(batcher *Batcher, users ...string) []error {
+	errs := make([]error, len(users))
+
+	var wait sync.WaitGroup
+	for index, user := range users {
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			errs[index] = batcher.Write(context.Background(), testStoreId, nil, client.ClientTupleKey{
+				User:     user,
+				Relation: "viewer",
+				Object:   "document:1",
+			})
+		}()
+	}
+	wait.Wait()
+
+	return errs
+}
+
+func TestBatcher(t *testing.T) {
+	t.Run("coalesces concurrent operations", func(t *testing.T) {
+		batcher, server := newTestBatcher(t, 50*time.Millisecond, 100)
+
+		var wait sync.WaitGroup
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			err := batcher.Delete(context.Background(), testStoreId, nil, client.ClientTupleKeyWithoutCondition{
+				User:     "user:carol",
+				Relation: "viewer",
+				Object:   "document:1",
+			})
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		}()
+
+		for _, err := range writeConcurrently(batcher, "user:anne", "user:bob") {
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		}
+		wait.Wait()
+
+		requests := server.Requests()
+		if len(requests) != 1 || len(requests[0]) != 3 {
+			t.Fatalf("expected a single request with 3 tuples, got %v", requests)
+		}
+	})
+
+	t.Run("sends full batches immediately", func(t *testing.T) {
+		batcher, server := newTestBatcher(t, time.Hour, 2)
+
+		for _, err := range writeConcurrently(batcher, "user:anne", "user:bob") {
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		}
+
+		if requests := server.Requests(); len(requests) != 1 {
+			t.Fatalf("expected a single request, got %v", requests)
+		}
+	})
+
+	t.Run("returns the error of each tuple", func(t *testing.T) {
+		batcher, server := newTestBatcher(t, 50*time.Millisecond, 100)
+
+		errs := writeConcurrently(batcher, "user:anne", "user:invalid", "user:bob")
+		if errs[0] != nil || errs[2] != nil {
+			t.Fatalf("expected valid tuples to be written, got %v", errs)
+		}
+		if errs[1] == nil {
+			t.Fatalf("expected an error for the invalid tuple")
+		}
+
+		// The failed batch is followed by one request per tuple.
+		if requests := server.Requests(); len(requests) != 4 {
+			t.Fatalf("expected 4 requests, got %v", requests)
+		}
+	})
+	t.Run("treats conflicts of retried operations as success once read", func(t *testing.T) {
+		batcher, server := newTestBatcher(t, 50*time.Millisecond, 100)
+
+		var deleteErr error
+		var wait sync.WaitGroup
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			deleteErr = batcher.Delete(context.Background(), testStoreId, nil, client.ClientTupleKeyWithoutCondition{
+				User:     "user:missing",
+				Relation: "viewer",
+				Object:   "document:1",
+			})
+		}()
+
+		errs := writeConcurrently(batcher, "user:anne", "user:existing")
+		wait.Wait()
+
+		if errs[0] != nil || errs[1] != nil || deleteErr != nil {
+			t.Fatalf("expected conflicting tuples to succeed, got %v and %v", errs, deleteErr)
+		}
+
+		// The failed batch is followed by one request per tuple.
+		if requests := server.Requests(); len(requests) != 4 {
+			t.Fatalf("expected 4 requests, got %v", requests)
+		}
+	})
+
+	t.Run("returns conflicts with a different condition", func(t *testing.T) {
+		batcher, _ := newTestBatcher(t, 50*time.Millisecond, 100)
+
+		errs := writeConcurrently(batcher, "user:anne", "user:conditional")
+		if errs[0] != nil {
+			t.Fatalf("unexpected error: %s", errs[0])
+		}
+		if errs[1] == nil {
+			t.Fatalf("expected an error for the tuple with a different condition")
+		}
+	})
+
+	t.Run("returns conflicts of rejected batches", func(t *testing.T) {
+		batcher, _ := newTestBatcher(t, 50*time.Millisecond, 100)
+
+		// The invalid tuple rules out that the batch was written
+		errs := writeConcurrently(batcher, "user:anne", "user:existing", "user:invalid")
+		if errs[0] != nil {
+			t.Fatalf("unexpected error: %s", errs[0])
+		}
+		if errs[1] == nil || errs[2] == nil {
+			t.Fatalf("expected errors for the existing and the invalid tuple, got %v", errs)
+		}
+	})
+
+	t.Run("returns conflicts of single operations", func(t *testing.T) {
+		batcher, _ := newTestBatcher(t, 10*time.Millisecond, 100)
+
+		if errs := writeConcurrently(batcher, "user:existing"); errs[0] == nil {
+			t.Fatalf("expected an error for the existing tuple")
+		}
+	})
+
+	t.Run("stops waiting once the context is done", func(t *testing.T) {
+		batcher, _ := newTestBatcher(t, time.Hour, 100)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		err := batcher.Write(ctx, testStoreId, nil, client.ClientTupleKey{
+			User:     "user:anne",
+			Relation: "viewer",
+			Object:   "document:1",
+		})
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("expected the deadline to be exceeded, got %v", err)
+		}
+	})
+}